@@ -0,0 +1,110 @@
+package binder
+
+import (
+	"errors"
+	"net/http"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// BindAll binds the passed v pointer to all the request sources using the default binder instance.
+// See Instance.BindAll for details.
+// Implements the binder.BinderFunc interface.
+func BindAll(r *http.Request, v interface{}) error {
+	return defaultInstance.BindAll(r, v)
+}
+
+// BindAll binds the passed v pointer to all the request sources at once.
+// `v` param should be a pointer to a struct with `path`, `query`, `header`, `cookie`, `form`, `json` or `xml` tags.
+// The struct tags are inspected before binding and only the sources referenced by them are bound.
+// The request body is bound with the decoder registered for its content type, see RegisterDecoder,
+// if the request method is POST, PUT or PATCH, and the struct has fields with the form or body format tags,
+// e.g. `json` or `xml`, or fields without any binding tag, which the body formats match by name.
+// Protobuf messages are always bound from the body.
+//
+// Sources are bound in the following order: body, cookie, header, query, path.
+// So if several sources set the same field, the value from the later source wins,
+// e.g. an ID from the path cannot be overridden by the request body.
+// The default values are set only to the fields not bound from the earlier sources,
+// e.g. a default value of the absent query key does not override the value from the body.
+// The bound value is validated once all the sources are bound, see WithValidator.
+func (b *Instance) BindAll(r *http.Request, v interface{}) error {
+	// Validate v pointer before binding into it
+	if !isPointer(v) {
+		return errors.Join(ErrInvalidInput, ErrTargetMustBeAPointer)
+	}
+
+	// Bind the sources with a copy of the binder, which keeps the values bound from the earlier sources
+	c := *b.trackStoredFiles()
+	c.keepBound = true
+	b = &c
+
+	tags := append([]string{b.cookieTag, b.headerTag, b.queryTag, b.pathTag, b.formTag}, bodyTags...)
+	used := usedTags(v, tags...)
+
+	// Bind the request body depending on the content type
+	if hasBody(r) && b.bindsBody(v, used) {
+		d, err := b.bodyDecoder(r)
+		if err != nil {
+			return err
+		}
+		if err := b.decodeBody(r, v, d.Decode); err != nil {
+			return err
+		}
+	}
+
+	// Remove the files stored while binding the body if any of the following steps fails
+	if err := b.bindSources(r, v, used); err != nil {
+		b.deleteStoredFiles(r.Context())
+		return err
+	}
+
+	return nil
+}
+
+// bodyTags are the struct tags of the body formats decoded by the built-in decoders.
+var bodyTags = []string{"json", "xml", "yaml", "toml", "msgpack", "cbor", "protobuf"}
+
+// bindsBody reports whether the request body is bound into the v pointer, given the tags used by its fields.
+// The fields without any binding tag are bound from the body, as the body formats match the fields by name.
+func (b *Instance) bindsBody(v interface{}, used map[string]bool) bool {
+	if _, ok := v.(proto.Message); ok || used[""] || used[b.formTag] {
+		return true
+	}
+	for _, tag := range bodyTags {
+		if used[tag] {
+			return true
+		}
+	}
+	return false
+}
+
+// bindSources binds the passed v pointer to the request sources referenced by its tags, except the body,
+// and validates the bound value.
+func (b *Instance) bindSources(r *http.Request, v interface{}, used map[string]bool) error {
+	if used[b.cookieTag] {
+		if err := b.bindCookie(r, v); err != nil {
+			return err
+		}
+	}
+
+	if used[b.headerTag] {
+		if err := b.bindHeader(r, v); err != nil {
+			return err
+		}
+	}
+
+	if used[b.queryTag] && (r.URL.RawQuery != "" || b.bindsEmptyQuery(v)) {
+		if err := b.decodeQuery(r, v); err != nil {
+			return err
+		}
+	}
+
+	if used[b.pathTag] {
+		if err := b.bindPath(r, v); err != nil {
+			return err
+		}
+	}
+
+	return b.validate(v)
+}
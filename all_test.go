@@ -0,0 +1,156 @@
+package binder_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestBindAll(t *testing.T) {
+	type UpdatePost struct {
+		ID       int    `path:"id" json:"-"`
+		Notify   bool   `query:"notify" json:"-"`
+		TenantID string `header:"X-Tenant-ID" json:"-"`
+		Session  string `cookie:"session" json:"-"`
+		Title    string `json:"title"`
+		Body     string `json:"body"`
+	}
+
+	// serve the request with http.ServeMux and bind all sources in the handler
+	serve := func(req *http.Request, v interface{}) error {
+		var err error
+		mux := http.NewServeMux()
+		mux.HandleFunc("/posts/{id}", func(_ http.ResponseWriter, r *http.Request) {
+			err = binder.BindAll(r, v)
+		})
+		mux.ServeHTTP(httptest.NewRecorder(), req)
+		return err
+	}
+
+	t.Run("PATCH with JSON body", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPatch, "/posts/42?notify=true", strings.NewReader(`{"title":"Hello","body":"World"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Tenant-ID", "acme")
+		req.AddCookie(&http.Cookie{Name: "session", Value: "abc123"})
+
+		var post UpdatePost
+		err := serve(req, &post)
+		require.NoError(t, err)
+		require.Equal(t, 42, post.ID)
+		require.True(t, post.Notify)
+		require.Equal(t, "acme", post.TenantID)
+		require.Equal(t, "abc123", post.Session)
+		require.Equal(t, "Hello", post.Title)
+		require.Equal(t, "World", post.Body)
+	})
+
+	t.Run("path takes precedence over body", func(t *testing.T) {
+		type Payload struct {
+			ID   int    `path:"id" form:"id"`
+			Name string `form:"name"`
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/posts/42", strings.NewReader("id=7&name=john"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		var payload Payload
+		err := serve(req, &payload)
+		require.NoError(t, err)
+		require.Equal(t, 42, payload.ID)
+		require.Equal(t, "john", payload.Name)
+	})
+
+	t.Run("GET without body", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/posts/42?notify=1", nil)
+
+		var post UpdatePost
+		err := serve(req, &post)
+		require.NoError(t, err)
+		require.Equal(t, 42, post.ID)
+		require.True(t, post.Notify)
+	})
+
+	t.Run("invalid content type", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/posts/42", strings.NewReader("hello"))
+		req.Header.Set("Content-Type", "text/plain")
+
+		var post UpdatePost
+		err := serve(req, &post)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrInvalidContentType)
+	})
+
+	t.Run("body without body fields", func(t *testing.T) {
+		type Params struct {
+			ID     int  `path:"id"`
+			Notify bool `query:"notify"`
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/posts/42?notify=true", strings.NewReader("hello"))
+		req.Header.Set("Content-Type", "text/plain")
+
+		var params Params
+		err := serve(req, &params)
+		require.NoError(t, err)
+		require.Equal(t, 42, params.ID)
+		require.True(t, params.Notify)
+	})
+
+	t.Run("untagged fields are bound from body", func(t *testing.T) {
+		type Payload struct {
+			ID    int `path:"id"`
+			Title string
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/posts/42", strings.NewReader(`{"title":"Hello"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		var payload Payload
+		err := serve(req, &payload)
+		require.NoError(t, err)
+		require.Equal(t, 42, payload.ID)
+		require.Equal(t, "Hello", payload.Title)
+	})
+
+	t.Run("default does not override body", func(t *testing.T) {
+		type Payload struct {
+			ID     int    `path:"id"`
+			Limit  int    `json:"limit" query:"limit" default:"20"`
+			Sort   string `json:"sort" query:"sort" default:"id"`
+			Cursor string `json:"cursor" header:"X-Cursor" default:"start"`
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/posts/42", strings.NewReader(`{"limit":50,"cursor":"abc"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		var payload Payload
+		err := serve(req, &payload)
+		require.NoError(t, err)
+		require.Equal(t, 50, payload.Limit)
+		require.Equal(t, "id", payload.Sort)
+		require.Equal(t, "abc", payload.Cursor)
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/posts/abc", nil)
+
+		var post UpdatePost
+		err := serve(req, &post)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrDecodePath)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/posts/42", nil)
+
+		var post UpdatePost
+		err := binder.BindAll(req, post)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrInvalidInput)
+	})
+}
@@ -1,9 +1,6 @@
 package binder
 
-import (
-	"net/http"
-	"strings"
-)
+import "net/http"
 
 // Binder is the interface that wraps the Bind method.
 //
@@ -14,6 +11,7 @@ type Binder interface {
 }
 
 // DefaultBinder is the default implementation of the Binder interface.
+// It is a thin wrapper over the default binder instance, see New to create a configured one.
 type DefaultBinder struct{}
 
 // Bind binds the passed v pointer to the request.
@@ -35,24 +33,9 @@ func (b *DefaultBinder) Bind(r *http.Request, v interface{}) error {
 // If the request method is GET, HEAD, DELETE, or OPTIONS, then the binding is done from the query.
 // If the request method is POST, PUT, or PATCH, then the binding is done from the request body.
 // If the content type is JSON, then the binding is done from the request body.
+// If the content type is XML, YAML, TOML, MessagePack, CBOR or protobuf, then the binding is done from the request body.
 // If the content type is form, then the binding is done from the request body.
+// It uses the default binder instance, see New to create a configured one.
 func BindFunc(r *http.Request, v interface{}) error {
-	switch r.Method {
-	case http.MethodGet, http.MethodHead, http.MethodDelete, http.MethodOptions:
-		return BindQuery(r, v)
-	case http.MethodPost, http.MethodPut, http.MethodPatch:
-		contentType := strings.ToLower(r.Header.Get("Content-Type"))
-		switch {
-		case strings.HasPrefix(contentType, "application/json"):
-			return BindJSON(r, v)
-		case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
-			return BindForm(r, v)
-		case strings.HasPrefix(contentType, "multipart/form-data"):
-			return BindFormMultipart(r, v)
-		default:
-			return ErrInvalidContentType
-		}
-	default:
-		return ErrInvalidMethod
-	}
+	return defaultInstance.Bind(r, v)
 }
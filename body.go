@@ -0,0 +1,107 @@
+package binder
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// MaxBodySize is the maximum size of the request body in bytes.
+// Zero means no limit, which is the default value.
+// It is used by binder instances created without WithMaxBodySize option.
+var MaxBodySize int64
+
+// MaxBodySize returns a copy of the binder with the given maximum size of the request body in bytes,
+// e.g. to allow larger uploads on a single route. See WithMaxBodySize for details.
+// The copy shares the decoders with the original binder.
+func (b *Instance) MaxBodySize(n int64) *Instance {
+	c := *b
+	c.maxBodySize = n
+	return &c
+}
+
+// bodyLimit returns the maximum size of the request body in bytes, or zero if there is no limit.
+func (b *Instance) bodyLimit() int64 {
+	switch {
+	case b.maxBodySize > 0:
+		return b.maxBodySize
+	case b.maxBodySize < 0:
+		return 0
+	default:
+		return MaxBodySize
+	}
+}
+
+// decodeBody decodes the request body with the decode function, limiting the body size, see WithMaxBodySize.
+// The body is decompressed according to the Content-Encoding header while it is decoded, see WithMaxDecompressedSize.
+// It returns ErrBodyTooLarge if the body exceeds the limits, whatever error the decode function returns.
+func (b *Instance) decodeBody(r *http.Request, v interface{}, decode func(r *http.Request, v interface{}) error) error {
+	if r.Body == nil || r.Body == http.NoBody {
+		return decode(r, v)
+	}
+
+	// Limit the body as it is sent, i.e. before decompression
+	var limited *maxBytesReader
+	if limit := b.bodyLimit(); limit > 0 {
+		// The body is rejected early if its declared size exceeds the limit
+		if r.ContentLength > limit {
+			return fmt.Errorf("%w: limit is %d bytes", ErrBodyTooLarge, limit)
+		}
+		limited = &maxBytesReader{body: r.Body, remaining: limit, limit: limit}
+		r.Body = limited
+	}
+
+	decompressed := b.decompressBody(r)
+
+	if err := decode(r, v); err != nil {
+		switch {
+		case limited != nil && limited.err != nil:
+			return limited.err
+		case decompressed != nil && decompressed.err != nil:
+			return decompressed.err
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// maxBytesReader is like the reader returned by http.MaxBytesReader,
+// but it fails with ErrBodyTooLarge and keeps the error, so it can be reported
+// whatever the decoder does with the read error.
+type maxBytesReader struct {
+	body      io.ReadCloser
+	remaining int64
+	limit     int64
+	err       error
+}
+
+// Read implements the io.Reader interface.
+func (l *maxBytesReader) Read(p []byte) (int, error) {
+	if l.err != nil {
+		return 0, l.err
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	// Read one byte more than the limit to find out whether the body exceeds it
+	if int64(len(p))-1 > l.remaining {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.body.Read(p)
+	if int64(n) <= l.remaining {
+		l.remaining -= int64(n)
+		return n, err
+	}
+
+	n = int(l.remaining)
+	l.remaining = 0
+	l.err = fmt.Errorf("%w: limit is %d bytes", ErrBodyTooLarge, l.limit)
+	return n, l.err
+}
+
+// Close implements the io.Closer interface.
+func (l *maxBytesReader) Close() error {
+	return l.body.Close()
+}
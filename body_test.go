@@ -0,0 +1,130 @@
+package binder_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestMaxBodySize(t *testing.T) {
+	type Payload struct {
+		Name string `json:"name" form:"name"`
+	}
+
+	bigName := strings.Repeat("a", 1024)
+
+	// the bodies are sent with an unknown length, so the limit is checked while the body is read
+	bigJSON := []byte(`{"name":"` + bigName + `"}`)
+
+	t.Run("no limit by default", func(t *testing.T) {
+		var payload Payload
+		req, err := newChunkedRequest(http.MethodPost, "/", "application/json", bigJSON, nil)
+		require.NoError(t, err)
+		err = binder.BindJSON(req, &payload)
+		require.NoError(t, err)
+		require.Equal(t, bigName, payload.Name)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b := binder.New(binder.WithMaxBodySize(512))
+
+		var payload Payload
+		req, err := newChunkedRequest(http.MethodPost, "/", "application/json", bigJSON, nil)
+		require.NoError(t, err)
+		err = b.BindJSON(req, &payload)
+		require.ErrorIs(t, err, binder.ErrBodyTooLarge)
+		require.Equal(t, http.StatusRequestEntityTooLarge, binder.ErrorStatus(err))
+
+		req, err = newChunkedRequest(http.MethodPost, "/", "application/json", []byte(`{"name":"John"}`), nil)
+		require.NoError(t, err)
+		err = b.BindJSON(req, &payload)
+		require.NoError(t, err)
+		require.Equal(t, "John", payload.Name)
+	})
+
+	t.Run("declared content length", func(t *testing.T) {
+		b := binder.New(binder.WithMaxBodySize(512))
+
+		var payload Payload
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", bigJSON, nil)
+		require.NoError(t, err)
+		err = b.Bind(req, &payload)
+		require.ErrorIs(t, err, binder.ErrBodyTooLarge)
+	})
+
+	t.Run("form", func(t *testing.T) {
+		b := binder.New(binder.WithMaxBodySize(512))
+
+		var payload Payload
+		req, err := newChunkedRequest(http.MethodPost, "/", "application/x-www-form-urlencoded", []byte("name="+bigName), nil)
+		require.NoError(t, err)
+		err = b.BindForm(req, &payload)
+		require.ErrorIs(t, err, binder.ErrBodyTooLarge)
+	})
+
+	t.Run("multipart", func(t *testing.T) {
+		b := binder.New(binder.WithMaxBodySize(512))
+
+		var payload Payload
+		req, err := newMultipartRequest(http.MethodPost, "/", [][2]string{{"name", bigName}}, nil, nil)
+		require.NoError(t, err)
+		req.ContentLength = -1
+		err = b.Bind(req, &payload)
+		require.ErrorIs(t, err, binder.ErrBodyTooLarge)
+	})
+
+	t.Run("per call", func(t *testing.T) {
+		b := binder.New(binder.WithMaxBodySize(512))
+
+		var payload Payload
+		req, err := newChunkedRequest(http.MethodPost, "/", "application/json", bigJSON, nil)
+		require.NoError(t, err)
+		err = b.MaxBodySize(4096).BindJSON(req, &payload)
+		require.NoError(t, err)
+
+		req, err = newBodyRequest(http.MethodPost, "/", "application/json", bigJSON, nil)
+		require.NoError(t, err)
+		err = b.MaxBodySize(-1).Bind(req, &payload)
+		require.NoError(t, err)
+
+		req, err = newChunkedRequest(http.MethodPost, "/", "application/json", bigJSON, nil)
+		require.NoError(t, err)
+		err = binder.New().MaxBodySize(16).BindJSON(req, &payload)
+		require.ErrorIs(t, err, binder.ErrBodyTooLarge)
+	})
+
+	t.Run("global", func(t *testing.T) {
+		defer func(size int64) {
+			binder.MaxBodySize = size
+		}(binder.MaxBodySize)
+		binder.MaxBodySize = 512
+
+		var payload Payload
+		req, err := newChunkedRequest(http.MethodPost, "/", "application/json", bigJSON, nil)
+		require.NoError(t, err)
+		err = binder.BindJSON(req, &payload)
+		require.ErrorIs(t, err, binder.ErrBodyTooLarge)
+
+		// the instance limit takes precedence
+		req, err = newChunkedRequest(http.MethodPost, "/", "application/json", bigJSON, nil)
+		require.NoError(t, err)
+		err = binder.New(binder.WithMaxBodySize(-1)).BindJSON(req, &payload)
+		require.NoError(t, err)
+	})
+
+	t.Run("problem details", func(t *testing.T) {
+		b := binder.New(binder.WithMaxBodySize(16))
+
+		var payload Payload
+		req, err := newChunkedRequest(http.MethodPost, "/", "application/json", bigJSON, nil)
+		require.NoError(t, err)
+		p := binder.NewProblem(b.BindJSON(req, &payload))
+		require.NotNil(t, p)
+		require.Equal(t, http.StatusRequestEntityTooLarge, p.Status)
+		require.Equal(t, binder.ErrBodyTooLarge.Error(), p.Detail)
+	})
+}
@@ -0,0 +1,42 @@
+package binder
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// BindCBOR binds the passed v pointer to the request using the default binder instance.
+// See Instance.BindCBOR for details.
+// Implements the binder.BinderFunc interface.
+func BindCBOR(r *http.Request, v interface{}) error {
+	return defaultInstance.BindCBOR(r, v)
+}
+
+// BindCBOR binds the passed v pointer to the request.
+// It uses the application/cbor content type for binding.
+// `v` param should be a pointer to a struct with `json` or `cbor` tags,
+// so the same struct can be bound from JSON and CBOR.
+// The bound value is validated afterwards, see WithValidator.
+func (b *Instance) BindCBOR(r *http.Request, v interface{}) error {
+	if err := b.decodeBody(r, v, b.bindCBOR); err != nil {
+		return err
+	}
+	return b.validate(v)
+}
+
+// bindCBOR binds the passed v pointer to the request CBOR body without validation.
+func (b *Instance) bindCBOR(r *http.Request, v interface{}) error {
+	// Check the request and the v pointer before decoding the body into it
+	if err := checkBodyRequest(r, v, MIMEApplicationCBOR); err != nil {
+		return err
+	}
+
+	// Decode the request body into the v pointer
+	if err := cbor.NewDecoder(r.Body).Decode(v); err != nil {
+		return errors.Join(ErrDecodeCBOR, err)
+	}
+
+	return nil
+}
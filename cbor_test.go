@@ -0,0 +1,57 @@
+package binder_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestBindCBOR(t *testing.T) {
+	// A test struct with json tags
+	type RequestBody struct {
+		FieldOne string `json:"field_one"`
+		FieldTwo int    `json:"field_two"`
+	}
+
+	// new cbor request with body
+	newCBORRequest := func(method string, body []byte) *http.Request {
+		req, err := http.NewRequest(method, "/", bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/cbor")
+		return req
+	}
+
+	payload, err := cbor.Marshal(map[string]interface{}{
+		"field_one": "value",
+		"field_two": 123,
+	})
+	require.NoError(t, err)
+
+	t.Run("invalid method", func(t *testing.T) {
+		err := binder.BindCBOR(newCBORRequest(http.MethodGet, payload), &RequestBody{})
+		require.ErrorIs(t, err, binder.ErrInvalidMethod)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		err := binder.BindCBOR(newCBORRequest(http.MethodPost, payload), RequestBody{})
+		require.ErrorIs(t, err, binder.ErrInvalidInput)
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		err := binder.BindCBOR(newCBORRequest(http.MethodPost, payload[:5]), &RequestBody{})
+		require.ErrorIs(t, err, binder.ErrDecodeCBOR)
+	})
+
+	t.Run("successful case", func(t *testing.T) {
+		obj := &RequestBody{}
+		err := binder.BindFunc(newCBORRequest(http.MethodPost, payload), obj)
+		require.NoError(t, err)
+		require.Equal(t, "value", obj.FieldOne)
+		require.Equal(t, 123, obj.FieldTwo)
+	})
+}
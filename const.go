@@ -6,9 +6,40 @@ const (
 	TagForm = "form"
 	// TagQuery Query struct tag name for binding
 	TagQuery = "query"
+	// TagPath Path parameter struct tag name for binding
+	TagPath = "path"
+	// TagHeader Header struct tag name for binding
+	TagHeader = "header"
+	// TagCookie Cookie struct tag name for binding
+	TagCookie = "cookie"
+	// TagDefault Default value struct tag name, e.g. `query:"limit" default:"20"`
+	TagDefault = "default"
+)
+
+// Tag options, set after the name in the struct tag
+const (
+	// OptionMaxSize limits the size of the uploaded file, e.g. `form:"avatar,maxsize=5MB"`.
+	OptionMaxSize = "maxsize"
+	// OptionMime limits the MIME types of the uploaded file, e.g. `form:"avatar,mime=image/png|image/jpeg"`.
+	// Wildcards are allowed, e.g. `mime=image/*`.
+	OptionMime = "mime"
+	// OptionRequired reports the absent or empty value as the missing field error,
+	// e.g. `query:"page,required"`.
+	OptionRequired = "required"
+	// OptionSplit splits the comma-separated multipart form values bound to a slice or array,
+	// e.g. `form:"tags,split"` binds "a,b" as two elements.
+	OptionSplit = "split"
+	// OptionLayout sets the layout of the time.Time value, e.g. `query:"since,layout=2006-01-02"`.
+	// RFC 3339 is used by default.
+	OptionLayout = "layout"
+	// OptionUnix parses the time.Time value as Unix seconds, e.g. `query:"since,unix"`.
+	OptionUnix = "unix"
+	// OptionUnixMilli parses the time.Time value as Unix milliseconds, e.g. `query:"since,unixmilli"`.
+	OptionUnixMilli = "unixmilli"
 )
 
 // MultiPartFormMaxMemory is the maximum amount of memory to use when parsing a multipart form.
 // It is passed to http.Request.ParseMultipartForm.
 // Default value is 32 << 20 (32 MB).
+// It is used by binder instances created without WithMultipartMaxMemory option.
 var MultiPartFormMaxMemory int64 = 32 << 20
@@ -0,0 +1,93 @@
+package binder
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// fileConstraints are the upload constraints of the file field, parsed from its tag options.
+type fileConstraints struct {
+	// maxSize is the maximum size of the file in bytes, zero means no limit.
+	maxSize int64
+	// maxSizeRaw is the maximum size as written in the tag, used in error messages.
+	maxSizeRaw string
+	// mimeTypes are the allowed MIME types, empty means any type.
+	mimeTypes []string
+}
+
+// parseFileConstraints parses the upload constraints from the tag options.
+func parseFileConstraints(options []string) (fileConstraints, error) {
+	var c fileConstraints
+	for _, option := range options {
+		key, value, _ := strings.Cut(option, "=")
+		switch strings.TrimSpace(key) {
+		case OptionMaxSize:
+			size, err := parseSize(value)
+			if err != nil {
+				return c, fmt.Errorf("%w: %s: %w", ErrInvalidInput, option, err)
+			}
+			c.maxSize, c.maxSizeRaw = size, value
+		case OptionMime:
+			for _, mimeType := range strings.Split(value, "|") {
+				if mimeType = strings.ToLower(strings.TrimSpace(mimeType)); mimeType != "" {
+					c.mimeTypes = append(c.mimeTypes, mimeType)
+				}
+			}
+		}
+	}
+	return c, nil
+}
+
+// checkSize checks the file size against the maximum size.
+func (c fileConstraints) checkSize(size int64) error {
+	if c.maxSize > 0 && size > c.maxSize {
+		return fmt.Errorf("%w: maximum size is %s", ErrFileTooLarge, c.maxSizeRaw)
+	}
+	return nil
+}
+
+// checkMimeType checks the file MIME type against the allowed MIME types.
+func (c fileConstraints) checkMimeType(mimeType string) error {
+	if len(c.mimeTypes) == 0 {
+		return nil
+	}
+	for _, candidate := range mediaTypeCandidates(strings.ToLower(mimeType)) {
+		for _, allowed := range c.mimeTypes {
+			if candidate == allowed {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%w: %s is not allowed", ErrInvalidFileType, mimeType)
+}
+
+// parseSize parses the human-readable size, e.g. "512", "100KB" or "5MB".
+// The units are powers of 1024.
+func parseSize(s string) (int64, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	multiplier := int64(1)
+	for _, unit := range []struct {
+		suffix     string
+		multiplier int64
+	}{
+		{"GB", 1 << 30},
+		{"MB", 1 << 20},
+		{"KB", 1 << 10},
+		{"B", 1},
+	} {
+		if strings.HasSuffix(s, unit.suffix) {
+			s, multiplier = strings.TrimSpace(strings.TrimSuffix(s, unit.suffix)), unit.multiplier
+			break
+		}
+	}
+
+	size, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if size < 0 {
+		return 0, strconv.ErrRange
+	}
+	return size * multiplier, nil
+}
@@ -0,0 +1,110 @@
+package binder_test
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestUploadConstraints(t *testing.T) {
+	testImage, err := os.ReadFile("testdata/test.jpg")
+	require.NoError(t, err)
+
+	// the multipart form has the name field and the avatar file
+	fields := [][2]string{{"name", "John"}}
+	avatar := []multipartFile{{"avatar", "test.jpg", testImage}}
+
+	t.Run("allowed file", func(t *testing.T) {
+		var form struct {
+			Name   string       `form:"name"`
+			Avatar *binder.File `form:"avatar,maxsize=5MB,mime=image/png|image/jpeg"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", fields, avatar, nil)
+		require.NoError(t, err)
+		err = binder.BindFormMultipart(req, &form)
+		require.NoError(t, err)
+		require.Equal(t, "John", form.Name)
+		require.NotNil(t, form.Avatar)
+		require.Equal(t, "image/jpeg", form.Avatar.ContentType)
+		require.Equal(t, testImage, form.Avatar.Data)
+	})
+
+	t.Run("wildcard mime type", func(t *testing.T) {
+		var form struct {
+			Avatar *binder.File `form:"avatar,mime=image/*"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", fields, avatar, nil)
+		require.NoError(t, err)
+		err = binder.BindFormMultipart(req, &form)
+		require.NoError(t, err)
+		require.NotNil(t, form.Avatar)
+	})
+
+	t.Run("file too large", func(t *testing.T) {
+		var form struct {
+			Name   string       `form:"name"`
+			Avatar *binder.File `form:"avatar,maxsize=1KB"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", fields, avatar, nil)
+		require.NoError(t, err)
+		err = binder.BindFormMultipart(req, &form)
+		require.ErrorIs(t, err, binder.ErrDecodeForm)
+		require.ErrorIs(t, err, binder.ErrFileTooLarge)
+		require.Nil(t, form.Avatar)
+
+		var errs binder.BindingErrors
+		require.True(t, errors.As(err, &errs))
+		require.Len(t, errs, 1)
+		require.Equal(t, "Avatar", errs[0].Field)
+		require.Equal(t, binder.SourceForm, errs[0].Source)
+		require.Equal(t, "avatar", errs[0].Tag)
+		require.Equal(t, "test.jpg", errs[0].Value)
+		require.Contains(t, errs[0].Error(), "maximum size is 1KB")
+	})
+
+	t.Run("disallowed mime type", func(t *testing.T) {
+		var form struct {
+			Avatar binder.File `form:"avatar,mime=image/png"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", fields, []multipartFile{{"avatar", "test.png", testImage}}, nil)
+		require.NoError(t, err)
+		err = binder.BindFormMultipart(req, &form)
+		require.ErrorIs(t, err, binder.ErrInvalidFileType)
+		require.Empty(t, form.Avatar.Data)
+
+		// the detected content type is reported, not the file name extension
+		var errs binder.BindingErrors
+		require.True(t, errors.As(err, &errs))
+		require.Contains(t, errs[0].Error(), "image/jpeg is not allowed")
+	})
+
+	t.Run("nothing is stored on violation", func(t *testing.T) {
+		store := binder.NewMemoryFileStore()
+		b := binder.New(binder.WithFileStore(store))
+
+		var form struct {
+			Avatar *binder.File `form:"avatar,mime=application/pdf"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", fields, avatar, nil)
+		require.NoError(t, err)
+		err = b.BindFormMultipart(req, &form)
+		require.ErrorIs(t, err, binder.ErrInvalidFileType)
+		require.Zero(t, store.Len())
+	})
+
+	t.Run("invalid max size", func(t *testing.T) {
+		var form struct {
+			Avatar *binder.File `form:"avatar,maxsize=big"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", fields, avatar, nil)
+		require.NoError(t, err)
+		err = binder.BindFormMultipart(req, &form)
+		require.ErrorIs(t, err, binder.ErrInvalidInput)
+		require.Equal(t, http.StatusInternalServerError, binder.ErrorStatus(err))
+	})
+}
@@ -1,6 +1,29 @@
 package binder
 
-import "reflect"
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gorilla/schema"
+)
+
+// Converter converts the string value of the query, form or multipart field to the value of the registered type.
+// It returns the invalid reflect.Value, i.e. reflect.Value{}, if the string cannot be converted.
+type Converter func(value string) reflect.Value
+
+// FormValueUnmarshaler is the interface implemented by types that can unmarshal themselves
+// from a single path, query, header, cookie, form or multipart value.
+// It takes precedence over encoding.TextUnmarshaler.
+type FormValueUnmarshaler interface {
+	UnmarshalFormValue(value string) error
+}
+
+var (
+	formValueUnmarshalerType = reflect.TypeOf((*FormValueUnmarshaler)(nil)).Elem()
+	textUnmarshalerType      = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
 
 // FileDataConverter is the function type that converts a string to a reflect.Value.
 // The string is the file data.
@@ -13,3 +36,160 @@ func FileDataConverter(_ string) reflect.Value {
 func FileDataConverterPtr(_ string) reflect.Value {
 	return reflect.ValueOf(&FileData{})
 }
+
+// RegisterConverter registers the converter for the type of the value on the default binder instance.
+// See Instance.RegisterConverter for details.
+func RegisterConverter(value interface{}, converter Converter) {
+	defaultInstance.RegisterConverter(value, converter)
+}
+
+// RegisterConverter registers the converter for the type of the value, e.g. uuid.UUID{}.
+// The converter is used by the query, form, multipart, path, header and cookie binding,
+// and takes precedence over FormValueUnmarshaler and encoding.TextUnmarshaler.
+// Converters should be registered before the binder is used, it is not safe to call concurrently with binding.
+func (b *Instance) RegisterConverter(value interface{}, converter Converter) {
+	b.converters[reflect.TypeOf(value)] = converter
+	for _, d := range b.schemaDecoders() {
+		d.RegisterConverter(value, schema.Converter(converter))
+	}
+}
+
+// convert converts the value with the converter registered for the field type.
+// It reports false if there is no such converter.
+func (b *Instance) convert(fieldValue reflect.Value, value string) (bool, error) {
+	converter, ok := b.converters[fieldValue.Type()]
+	if !ok {
+		return false, nil
+	}
+	converted := converter(value)
+	if !converted.IsValid() || !converted.Type().AssignableTo(fieldValue.Type()) {
+		return true, ErrInvalidValue
+	}
+	fieldValue.Set(converted)
+	return true, nil
+}
+
+// unmarshalerOf returns the field value as the given unmarshaler interface, or nil if it does not implement it.
+// Nil pointers are allocated.
+func unmarshalerOf(fieldValue reflect.Value, iface reflect.Type) interface{} {
+	if fieldValue.Kind() == reflect.Ptr && fieldValue.Type().Implements(iface) {
+		if fieldValue.IsNil() {
+			fieldValue.Set(reflect.New(fieldValue.Type().Elem()))
+		}
+		return fieldValue.Interface()
+	}
+	if fieldValue.CanAddr() && reflect.PointerTo(fieldValue.Type()).Implements(iface) {
+		return fieldValue.Addr().Interface()
+	}
+	return nil
+}
+
+// implementsUnmarshaler reports whether the values of the type, or the pointers to them, implement the interface.
+func implementsUnmarshaler(t reflect.Type, iface reflect.Type) bool {
+	return t.Implements(iface) || reflect.PointerTo(t).Implements(iface)
+}
+
+// unmarshalValues binds the source values to the fields of the struct that v points to
+// implementing FormValueUnmarshaler, and their slices, as the schema decoders do not support the interface.
+// Slices of structs implementing encoding.TextUnmarshaler are bound too, as the schema decoders
+// expect them to be sent with indexed keys, e.g. "addrs.0".
+// The source map is copied if any field is bound, and the bound values are removed from the returned map,
+// so the schema decoders skip them.
+func (b *Instance) unmarshalValues(v interface{}, tag string, source Source, src map[string][]string) (map[string][]string, BindingErrors) {
+	var errs BindingErrors
+	values := src
+	copied := false
+	var walk func(targetElem reflect.Value)
+	walk = func(targetElem reflect.Value) {
+		for i := 0; i < targetElem.NumField(); i++ {
+			field := targetElem.Type().Field(i)
+			fieldValue := targetElem.Field(i)
+			name := strings.Split(field.Tag.Get(tag), ",")[0]
+
+			// Look into embedded structs without the tag
+			if name == "" && field.Anonymous {
+				if fieldValue.Kind() == reflect.Ptr && !fieldValue.IsNil() {
+					fieldValue = fieldValue.Elem()
+				}
+				if fieldValue.Kind() == reflect.Struct {
+					walk(fieldValue)
+				}
+				continue
+			}
+
+			if name == "" || name == "-" || !fieldValue.CanSet() || len(src[name]) == 0 {
+				continue
+			}
+			if !b.isUnmarshaledField(field.Type) {
+				continue
+			}
+
+			if err := unmarshalField(fieldValue, src[name]); err != nil {
+				errs = append(errs, &FieldError{
+					Field:  field.Name,
+					Source: source,
+					Tag:    name,
+					Value:  src[name][0],
+					Type:   field.Type.String(),
+					Err:    fmt.Errorf("%w: %w", ErrInvalidValue, err),
+				})
+			}
+
+			// Copy the source map before the first change, so the request values are kept intact
+			if !copied {
+				values = make(map[string][]string, len(src))
+				for key, value := range src {
+					values[key] = value
+				}
+				copied = true
+			}
+			delete(values, name)
+		}
+	}
+	walk(reflect.ValueOf(v).Elem())
+	return values, errs
+}
+
+// isUnmarshaledField reports whether the field of the type is bound by unmarshalValues.
+func (b *Instance) isUnmarshaledField(t reflect.Type) bool {
+	isSlice := t.Kind() == reflect.Slice
+	if isSlice {
+		t = t.Elem()
+	}
+	if _, ok := b.converters[t]; ok || isTimeType(t) {
+		return false
+	}
+	if implementsUnmarshaler(t, formValueUnmarshalerType) {
+		return true
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return isSlice && t.Kind() == reflect.Struct && implementsUnmarshaler(t, textUnmarshalerType)
+}
+
+// unmarshalField unmarshals the values into the field implementing FormValueUnmarshaler
+// or encoding.TextUnmarshaler, or into the slice of such values.
+func unmarshalField(fieldValue reflect.Value, values []string) error {
+	if fieldValue.Kind() != reflect.Slice {
+		return unmarshalValue(fieldValue, values[0])
+	}
+
+	list := reflect.MakeSlice(fieldValue.Type(), len(values), len(values))
+	for i, value := range values {
+		if err := unmarshalValue(list.Index(i), value); err != nil {
+			return err
+		}
+	}
+	fieldValue.Set(list)
+	return nil
+}
+
+// unmarshalValue unmarshals the value with FormValueUnmarshaler, or encoding.TextUnmarshaler
+// if the former is not implemented.
+func unmarshalValue(fieldValue reflect.Value, value string) error {
+	if u, ok := unmarshalerOf(fieldValue, formValueUnmarshalerType).(FormValueUnmarshaler); ok {
+		return u.UnmarshalFormValue(value)
+	}
+	return unmarshalerOf(fieldValue, textUnmarshalerType).(encoding.TextUnmarshaler).UnmarshalText([]byte(value))
+}
@@ -0,0 +1,201 @@
+package binder_test
+
+import (
+	"bytes"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+// Status is an enum type binding itself from the form values
+type Status int
+
+const (
+	StatusActive Status = iota + 1
+	StatusBlocked
+)
+
+func (s *Status) UnmarshalFormValue(value string) error {
+	switch value {
+	case "active":
+		*s = StatusActive
+	case "blocked":
+		*s = StatusBlocked
+	default:
+		return errors.New("unknown status")
+	}
+	return nil
+}
+
+// Level implements both interfaces, FormValueUnmarshaler must win
+type Level string
+
+func (l *Level) UnmarshalFormValue(value string) error {
+	*l = Level("form:" + value)
+	return nil
+}
+
+func (l *Level) UnmarshalText(text []byte) error {
+	*l = Level("text:" + string(text))
+	return nil
+}
+
+// ID is a type bound with the registered converter
+type ID struct {
+	Prefix string
+	Number string
+}
+
+func convertID(value string) reflect.Value {
+	prefix, number, ok := strings.Cut(value, "-")
+	if !ok {
+		return reflect.Value{}
+	}
+	return reflect.ValueOf(ID{Prefix: prefix, Number: number})
+}
+
+func TestConverters(t *testing.T) {
+	type Filter struct {
+		Status   Status       `query:"status" form:"status" header:"X-Status"`
+		Statuses []Status     `query:"statuses" form:"statuses"`
+		Level    *Level       `query:"level" form:"level"`
+		Addr     netip.Addr   `query:"addr" form:"addr" header:"X-Addr"`
+		Addrs    []netip.Addr `query:"addrs" form:"addrs"`
+		ID       ID           `query:"id" form:"id" header:"X-ID"`
+	}
+
+	b := binder.New()
+	b.RegisterConverter(ID{}, convertID)
+
+	values := [][2]string{
+		{"status", "active"},
+		{"statuses", "active"},
+		{"statuses", "blocked"},
+		{"level", "debug"},
+		{"addr", "192.168.0.1"},
+		{"addrs", "10.0.0.1"},
+		{"addrs", "::1"},
+		{"id", "user-42"},
+	}
+
+	requireFilter := func(t *testing.T, filter Filter) {
+		t.Helper()
+		require.Equal(t, StatusActive, filter.Status)
+		require.Equal(t, []Status{StatusActive, StatusBlocked}, filter.Statuses)
+		require.NotNil(t, filter.Level)
+		require.Equal(t, Level("form:debug"), *filter.Level)
+		require.Equal(t, netip.MustParseAddr("192.168.0.1"), filter.Addr)
+		require.Equal(t, []netip.Addr{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("::1")}, filter.Addrs)
+		require.Equal(t, ID{Prefix: "user", Number: "42"}, filter.ID)
+	}
+
+	t.Run("query", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		query := req.URL.Query()
+		for _, value := range values {
+			query.Add(value[0], value[1])
+		}
+		req.URL.RawQuery = query.Encode()
+
+		var filter Filter
+		require.NoError(t, b.BindQuery(req, &filter))
+		requireFilter(t, filter)
+	})
+
+	t.Run("form", func(t *testing.T) {
+		form := make([]string, 0, len(values))
+		for _, value := range values {
+			form = append(form, value[0]+"="+value[1])
+		}
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(strings.Join(form, "&")))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		var filter Filter
+		require.NoError(t, b.BindForm(req, &filter))
+		requireFilter(t, filter)
+	})
+
+	t.Run("multipart", func(t *testing.T) {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		for _, value := range values {
+			require.NoError(t, writer.WriteField(value[0], value[1]))
+		}
+		require.NoError(t, writer.Close())
+		req := httptest.NewRequest(http.MethodPost, "/", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+
+		var filter Filter
+		require.NoError(t, b.BindFormMultipart(req, &filter))
+		requireFilter(t, filter)
+	})
+
+	t.Run("header", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Status", "blocked")
+		req.Header.Set("X-Addr", "127.0.0.1")
+		req.Header.Set("X-ID", "order-7")
+
+		var filter Filter
+		require.NoError(t, b.BindHeader(req, &filter))
+		require.Equal(t, StatusBlocked, filter.Status)
+		require.Equal(t, netip.MustParseAddr("127.0.0.1"), filter.Addr)
+		require.Equal(t, ID{Prefix: "order", Number: "7"}, filter.ID)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/?status=deleted&addr=localhost&id=42", nil)
+
+		var filter Filter
+		err := b.BindQuery(req, &filter)
+		require.ErrorIs(t, err, binder.ErrDecodeQuery)
+		require.ErrorIs(t, err, binder.ErrInvalidValue)
+
+		var errs binder.BindingErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 3)
+		require.Equal(t, "Addr", errs[0].Field)
+		require.Equal(t, "ID", errs[1].Field)
+		require.Equal(t, "Status", errs[2].Field)
+		require.Equal(t, "deleted", errs[2].Value)
+		require.Contains(t, errs[2].Error(), "unknown status")
+	})
+
+	t.Run("invalid multipart values", func(t *testing.T) {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		require.NoError(t, writer.WriteField("statuses", "active"))
+		require.NoError(t, writer.WriteField("statuses", "deleted"))
+		require.NoError(t, writer.WriteField("id", "42"))
+		require.NoError(t, writer.Close())
+		req := httptest.NewRequest(http.MethodPost, "/", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+
+		var filter Filter
+		err := b.BindFormMultipart(req, &filter)
+		require.ErrorIs(t, err, binder.ErrInvalidValue)
+
+		var errs binder.BindingErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 2)
+		require.Equal(t, "Statuses[1]", errs[0].Field)
+		require.Equal(t, "ID", errs[1].Field)
+	})
+
+	t.Run("converters are per instance", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/?id=user-42", nil)
+
+		var filter Filter
+		require.Error(t, binder.New().BindQuery(req, &filter))
+		require.Equal(t, ID{}, filter.ID)
+	})
+}
@@ -0,0 +1,100 @@
+package binder
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// SignCookie signs the cookie value with the given key.
+// It returns the value with the HMAC-SHA256 signature appended,
+// which is verified by BindCookie for fields tagged with the "signed" option.
+// The cookie name is a part of the signed message, so a signed value
+// cannot be moved to another cookie.
+func SignCookie(key []byte, name, value string) string {
+	return value + "." + cookieSignature(key, name, value)
+}
+
+// BindCookie binds the passed v pointer to the request cookies using the default binder instance.
+// See Instance.BindCookie for details.
+// Implements the binder.BinderFunc interface.
+func BindCookie(r *http.Request, v interface{}) error {
+	return defaultInstance.BindCookie(r, v)
+}
+
+// BindCookie binds the passed v pointer to the request cookies.
+// `v` param should be a pointer to a struct with `cookie` tags, e.g. `cookie:"session_id"`.
+// Fields tagged with the "signed" option, e.g. `cookie:"prefs,signed"`, are verified
+// with the key set by WithCookieSigningKey, and tampered values are rejected with ErrInvalidCookieSig.
+// Values are converted the same way as in BindQuery.
+// The bound value is validated afterwards, see WithValidator.
+func (b *Instance) BindCookie(r *http.Request, v interface{}) error {
+	if err := b.bindCookie(r, v); err != nil {
+		return err
+	}
+	return b.validate(v)
+}
+
+// bindCookie binds the passed v pointer to the request cookies without validation.
+func (b *Instance) bindCookie(r *http.Request, v interface{}) error {
+	// Validate v pointer before decoding cookies into it
+	if !isPointer(v) {
+		return errors.Join(ErrInvalidInput, ErrTargetMustBeAPointer)
+	}
+
+	// Collect the cookies referenced by the struct tags
+	cookies := make(map[string][]string)
+	for _, field := range taggedFields(v, b.cookieTag) {
+		for _, cookie := range r.Cookies() {
+			if cookie.Name != field.name {
+				continue
+			}
+
+			value := cookie.Value
+			if field.hasOption("signed") {
+				var err error
+				if value, err = b.verifyCookie(cookie.Name, value); err != nil {
+					return err
+				}
+			}
+			cookies[field.name] = append(cookies[field.name], value)
+		}
+	}
+
+	// Decode the cookies into the v pointer and handle decoding errors
+	if err := b.decodeValues(b.cookieDecoder, v, b.cookieTag, SourceCookie, cookies); err != nil {
+		return errors.Join(ErrDecodeCookie, err)
+	}
+
+	return nil
+}
+
+// verifyCookie verifies the signed cookie value and returns the value without the signature.
+func (b *Instance) verifyCookie(name, signed string) (string, error) {
+	if len(b.cookieKey) == 0 {
+		return "", ErrEmptyCookieKey
+	}
+
+	i := strings.LastIndexByte(signed, '.')
+	if i < 0 {
+		return "", fmt.Errorf("%w: %s", ErrInvalidCookieSig, name)
+	}
+
+	value, signature := signed[:i], signed[i+1:]
+	if !hmac.Equal([]byte(signature), []byte(cookieSignature(b.cookieKey, name, value))) {
+		return "", fmt.Errorf("%w: %s", ErrInvalidCookieSig, name)
+	}
+
+	return value, nil
+}
+
+// cookieSignature returns the base64 encoded HMAC-SHA256 signature of the cookie.
+func cookieSignature(key []byte, name, value string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(name + "=" + value))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
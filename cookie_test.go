@@ -0,0 +1,95 @@
+package binder_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestBindCookie(t *testing.T) {
+	key := []byte("secret")
+
+	type Cookies struct {
+		SessionID string `cookie:"session_id"`
+		Visits    int    `cookie:"visits"`
+		Prefs     string `cookie:"prefs,signed"`
+	}
+
+	t.Run("success", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "abc123"})
+		req.AddCookie(&http.Cookie{Name: "visits", Value: "3"})
+		req.AddCookie(&http.Cookie{Name: "prefs", Value: binder.SignCookie(key, "prefs", "dark.mode")})
+
+		var cookies Cookies
+		err := binder.New(binder.WithCookieSigningKey(key)).BindCookie(req, &cookies)
+		require.NoError(t, err)
+		require.Equal(t, "abc123", cookies.SessionID)
+		require.Equal(t, 3, cookies.Visits)
+		require.Equal(t, "dark.mode", cookies.Prefs)
+	})
+
+	t.Run("tampered signed cookie", func(t *testing.T) {
+		signed := binder.SignCookie(key, "prefs", "dark")
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(&http.Cookie{Name: "prefs", Value: "light" + signed[len("dark"):]})
+
+		var cookies Cookies
+		err := binder.New(binder.WithCookieSigningKey(key)).BindCookie(req, &cookies)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrInvalidCookieSig)
+	})
+
+	t.Run("signature of another cookie", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(&http.Cookie{Name: "prefs", Value: binder.SignCookie(key, "other", "dark")})
+
+		var cookies Cookies
+		err := binder.New(binder.WithCookieSigningKey(key)).BindCookie(req, &cookies)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrInvalidCookieSig)
+	})
+
+	t.Run("unsigned value of signed cookie", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(&http.Cookie{Name: "prefs", Value: "dark"})
+
+		var cookies Cookies
+		err := binder.New(binder.WithCookieSigningKey(key)).BindCookie(req, &cookies)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrInvalidCookieSig)
+	})
+
+	t.Run("signing key is not set", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(&http.Cookie{Name: "prefs", Value: binder.SignCookie(key, "prefs", "dark")})
+
+		var cookies Cookies
+		err := binder.BindCookie(req, &cookies)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrEmptyCookieKey)
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(&http.Cookie{Name: "visits", Value: "many"})
+
+		var cookies Cookies
+		err := binder.BindCookie(req, &cookies)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrDecodeCookie)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		var cookies Cookies
+		err := binder.BindCookie(req, cookies)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrInvalidInput)
+	})
+}
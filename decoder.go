@@ -0,0 +1,212 @@
+package binder
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Media types of the built-in body decoders
+const (
+	MIMEApplicationJSON       = "application/json"
+	MIMEApplicationXML        = "application/xml"
+	MIMETextXML               = "text/xml"
+	MIMEApplicationForm       = "application/x-www-form-urlencoded"
+	MIMEMultipartForm         = "multipart/form-data"
+	MIMEApplicationYAML       = "application/yaml"
+	MIMEApplicationXYAML      = "application/x-yaml"
+	MIMETextYAML              = "text/yaml"
+	MIMEApplicationTOML       = "application/toml"
+	MIMEApplicationMsgPack    = "application/msgpack"
+	MIMEApplicationXMsgPack   = "application/x-msgpack"
+	MIMEApplicationVndMsgPack = "application/vnd.msgpack"
+	MIMEApplicationCBOR       = "application/cbor"
+	MIMEApplicationXProtobuf  = "application/x-protobuf"
+	MIMEApplicationProtobuf   = "application/protobuf"
+)
+
+// BodyDecoder is the interface that wraps the Decode method.
+//
+// Decode decodes the request body into the passed v pointer.
+// The binder validates the decoded value afterwards, so Decode should not do it.
+type BodyDecoder interface {
+	Decode(r *http.Request, v interface{}) error
+}
+
+// BodyDecoderFunc is an adapter to allow the use of ordinary functions as body decoders.
+type BodyDecoderFunc func(r *http.Request, v interface{}) error
+
+// Decode implements the BodyDecoder interface.
+func (f BodyDecoderFunc) Decode(r *http.Request, v interface{}) error {
+	return f(r, v)
+}
+
+// builtinDecoder is the body decoder implemented by the binder itself, e.g. the JSON decoder.
+// It is called with the binder in use rather than the one it was registered by,
+// so the binder copies, e.g. the ones returned by StrictJSON, decode with their own settings.
+type builtinDecoder struct {
+	owner  *Instance
+	decode func(b *Instance, r *http.Request, v interface{}) error
+}
+
+// Decode implements the BodyDecoder interface.
+func (d builtinDecoder) Decode(r *http.Request, v interface{}) error {
+	return d.decode(d.owner, r, v)
+}
+
+// builtin creates the built-in body decoder of the binder method expression, e.g. (*Instance).bindJSON.
+func (b *Instance) builtin(decode func(b *Instance, r *http.Request, v interface{}) error) BodyDecoder {
+	return builtinDecoder{owner: b, decode: decode}
+}
+
+// decoderRegistry maps media types to body decoders.
+// It is safe for concurrent use.
+type decoderRegistry struct {
+	mu       sync.RWMutex
+	decoders map[string]BodyDecoder
+}
+
+// set registers the decoder for the media type.
+func (dr *decoderRegistry) set(mediaType string, d BodyDecoder) {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	dr.decoders[strings.ToLower(mediaType)] = d
+}
+
+// get returns the decoder for the media type.
+// The most specific registered media type wins, see mediaTypeCandidates.
+func (dr *decoderRegistry) get(mediaType string) (BodyDecoder, bool) {
+	dr.mu.RLock()
+	defer dr.mu.RUnlock()
+	for _, candidate := range mediaTypeCandidates(mediaType) {
+		if d, ok := dr.decoders[candidate]; ok {
+			return d, true
+		}
+	}
+	return nil, false
+}
+
+// RegisterDecoder registers the body decoder for the media type on the default binder instance.
+// See Instance.RegisterDecoder for details.
+func RegisterDecoder(mediaType string, d BodyDecoder) {
+	defaultInstance.RegisterDecoder(mediaType, d)
+}
+
+// RegisterDecoder registers the body decoder for the media type, e.g. "application/json".
+// It replaces the decoder registered for the same media type, including the built-in ones.
+//
+// The media type may contain wildcards: "application/*" matches any application subtype,
+// "application/*+json" matches any subtype with the +json structured suffix, and "*/*" matches everything.
+// Media types with a structured suffix, e.g. "application/vnd.api+json",
+// fall back to the decoder of the suffix type, e.g. "application/json".
+func (b *Instance) RegisterDecoder(mediaType string, d BodyDecoder) {
+	b.decoders.set(mediaType, d)
+}
+
+// Accept returns a copy of the binder that binds request bodies of the given media types only,
+// e.g. to restrict a route to JSON. Other content types are rejected with ErrInvalidContentType.
+// The media types are matched the same way as the registered decoders.
+// The copy shares the decoders with the original binder.
+func (b *Instance) Accept(mediaTypes ...string) *Instance {
+	c := *b
+	c.accept = make([]string, 0, len(mediaTypes))
+	for _, mediaType := range mediaTypes {
+		c.accept = append(c.accept, strings.ToLower(mediaType))
+	}
+	return &c
+}
+
+// bodyDecoder returns the decoder for the request content type.
+// It returns ErrInvalidContentType if the content type is not accepted or has no decoder.
+func (b *Instance) bodyDecoder(r *http.Request) (BodyDecoder, error) {
+	contentType := r.Header.Get("Content-Type")
+	mediaType, err := parseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidContentType, contentType)
+	}
+
+	if !b.accepts(mediaType) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidContentType, mediaType)
+	}
+
+	d, ok := b.decoders.get(mediaType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidContentType, mediaType)
+	}
+
+	// Decode with the binder in use, which may be a copy of the registering one
+	if builtin, ok := d.(builtinDecoder); ok {
+		builtin.owner = b
+		return builtin, nil
+	}
+
+	return d, nil
+}
+
+// accepts reports whether the media type is allowed by the binder accept list.
+// Any media type is allowed if the list is empty.
+func (b *Instance) accepts(mediaType string) bool {
+	if len(b.accept) == 0 {
+		return true
+	}
+	for _, candidate := range mediaTypeCandidates(mediaType) {
+		for _, accepted := range b.accept {
+			if candidate == accepted {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseMediaType returns the lower-cased media type of the content type without parameters.
+func parseMediaType(contentType string) (string, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", err
+	}
+	if !strings.Contains(mediaType, "/") {
+		return "", fmt.Errorf("mime: missing subtype in %q", mediaType)
+	}
+	return mediaType, nil
+}
+
+// mediaTypeCandidates returns the media types matching the given one, from the most to the least specific.
+// For example, "application/vnd.api+json" is matched by "application/vnd.api+json",
+// "application/json", "application/*+json", "application/*" and "*/*".
+func mediaTypeCandidates(mediaType string) []string {
+	typ, subtype, _ := strings.Cut(mediaType, "/")
+	candidates := []string{mediaType}
+
+	// Structured syntax suffix, see RFC 6838
+	if i := strings.LastIndexByte(subtype, '+'); i >= 0 {
+		suffix := subtype[i+1:]
+		candidates = append(candidates, typ+"/"+suffix)
+		if typ != "application" {
+			candidates = append(candidates, "application/"+suffix)
+		}
+		candidates = append(candidates, typ+"/*+"+suffix)
+	}
+
+	return append(candidates, typ+"/*", "*/*")
+}
+
+// hasMediaType reports whether the request content type is one of the given media types,
+// including the media types with the matching structured suffix.
+// Wildcards are not taken into account.
+func hasMediaType(r *http.Request, mediaTypes ...string) bool {
+	mediaType, err := parseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	for _, candidate := range mediaTypeCandidates(mediaType) {
+		for _, mt := range mediaTypes {
+			if candidate == mt {
+				return true
+			}
+		}
+	}
+	return false
+}
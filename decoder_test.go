@@ -0,0 +1,109 @@
+package binder_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestRegisterDecoder(t *testing.T) {
+	type Payload struct {
+		Name string `json:"name" form:"name"`
+	}
+
+	// plain text decoder sets the body as the payload name
+	textDecoder := binder.BodyDecoderFunc(func(r *http.Request, v interface{}) error {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return err
+		}
+		v.(*Payload).Name = string(body)
+		return nil
+	})
+
+	t.Run("structured suffix", func(t *testing.T) {
+		var payload Payload
+		req, err := newBodyRequest(http.MethodPost, "/", "application/vnd.api+json; charset=utf-8", []byte(`{"name":"john"}`), nil)
+		require.NoError(t, err)
+		err = binder.New().Bind(req, &payload)
+		require.NoError(t, err)
+		require.Equal(t, "john", payload.Name)
+	})
+
+	t.Run("custom media type", func(t *testing.T) {
+		b := binder.New(binder.WithDecoder("text/plain", textDecoder))
+
+		var payload Payload
+		req, err := newBodyRequest(http.MethodPost, "/", "Text/Plain; charset=utf-8", []byte("john"), nil)
+		require.NoError(t, err)
+		err = b.Bind(req, &payload)
+		require.NoError(t, err)
+		require.Equal(t, "john", payload.Name)
+
+		// The other instances are not affected
+		req, err = newBodyRequest(http.MethodPost, "/", "text/plain", []byte("john"), nil)
+		require.NoError(t, err)
+		err = binder.New().Bind(req, &payload)
+		require.ErrorIs(t, err, binder.ErrInvalidContentType)
+	})
+
+	t.Run("wildcard", func(t *testing.T) {
+		b := binder.New()
+		b.RegisterDecoder("text/*", textDecoder)
+
+		var payload Payload
+		req, err := newBodyRequest(http.MethodPost, "/", "text/markdown", []byte("john"), nil)
+		require.NoError(t, err)
+		err = b.Bind(req, &payload)
+		require.NoError(t, err)
+		require.Equal(t, "john", payload.Name)
+
+		// The more specific media type wins over the wildcard
+		req, err = newBodyRequest(http.MethodPost, "/", "text/xml", []byte("<Payload><Name>jane</Name></Payload>"), nil)
+		require.NoError(t, err)
+		err = b.Bind(req, &payload)
+		require.NoError(t, err)
+		require.Equal(t, "jane", payload.Name)
+	})
+
+	t.Run("invalid content type", func(t *testing.T) {
+		var payload Payload
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json;;", []byte(`{"name":"john"}`), nil)
+		require.NoError(t, err)
+		err = binder.New().Bind(req, &payload)
+		require.ErrorIs(t, err, binder.ErrInvalidContentType)
+	})
+}
+
+func TestAccept(t *testing.T) {
+	type Payload struct {
+		Name string `json:"name" form:"name"`
+	}
+
+	b := binder.New()
+	jsonOnly := b.Accept("application/json")
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"john"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	var payload Payload
+	err := jsonOnly.Bind(req, &payload)
+	require.NoError(t, err)
+	require.Equal(t, "john", payload.Name)
+
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("name=john"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	err = jsonOnly.Bind(req, &payload)
+	require.ErrorIs(t, err, binder.ErrInvalidContentType)
+
+	// The original binder accepts any registered media type
+	err = b.Bind(req, &payload)
+	require.NoError(t, err)
+}
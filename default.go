@@ -0,0 +1,117 @@
+package binder
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// isAbsent reports whether the default value should be used instead of the values.
+// The values are absent if there are none, or if all of them are empty and the defaults
+// are applied to empty values, see WithDefaultOnEmpty.
+func (b *Instance) isAbsent(values []string) bool {
+	return len(values) == 0 || (b.defaultOnEmpty && isMissing(values))
+}
+
+// defaultValues returns the default tag value of the field type as the source values.
+// The default value of a slice or array field is split by commas, e.g. `default:"a,b"`.
+func defaultValues(typ reflect.Type, def string) []string {
+	if typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
+		return splitFormValues([]string{def})
+	}
+	return []string{def}
+}
+
+// hasDefaults reports whether any field with the given tag of the struct that v points to has the default value.
+func hasDefaults(v interface{}, tag string) bool {
+	if reflect.TypeOf(v).Elem().Kind() != reflect.Struct {
+		return false
+	}
+	for _, field := range taggedFields(v, tag) {
+		if _, ok := field.structTag.Lookup(TagDefault); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// applyDefaults sets the default values of the fields of the struct that v points to,
+// which are absent in the source map. The source map is copied if any default value is set.
+// The fields bound from the earlier sources are skipped, see isBound.
+func (b *Instance) applyDefaults(v interface{}, tag string, src map[string][]string) map[string][]string {
+	values := src
+	copied := false
+	for _, field := range taggedFields(v, tag) {
+		def, ok := field.structTag.Lookup(TagDefault)
+		if !ok || !b.isAbsent(src[field.name]) || b.isBound(v, field) {
+			continue
+		}
+
+		// Copy the source map before the first change, so the request values are kept intact
+		if !copied {
+			values = make(map[string][]string, len(src))
+			for key, value := range src {
+				values[key] = value
+			}
+			copied = true
+		}
+		values[field.name] = defaultValues(field.typ, def)
+	}
+	return values
+}
+
+// isBound reports whether the field of the struct that v points to is already set by an earlier source,
+// so its default value must not override it. It is only the case when the binder binds several sources
+// into the same value, see BindAll.
+func (b *Instance) isBound(v interface{}, field taggedField) bool {
+	if !b.keepBound {
+		return false
+	}
+	fieldValue, err := reflect.ValueOf(v).Elem().FieldByIndexErr(field.index)
+	return err == nil && !fieldValue.IsZero()
+}
+
+// setDefaults sets the default values to the zero-valued fields of the struct that v points to
+// before the body is decoded into it, so the default values are kept for the keys absent in the body.
+// The values set before binding, e.g. the stored ones to be patched, are kept intact.
+// The default values are converted the same way as the multipart form values.
+func (b *Instance) setDefaults(v interface{}) error {
+	targetElem := reflect.ValueOf(v).Elem()
+	if targetElem.Kind() != reflect.Struct {
+		return nil
+	}
+	return b.setStructDefaults(targetElem)
+}
+
+// setStructDefaults sets the default values to the zero-valued fields of the struct value,
+// including the fields of the embedded structs.
+func (b *Instance) setStructDefaults(targetElem reflect.Value) error {
+	for i := 0; i < targetElem.NumField(); i++ {
+		field := targetElem.Type().Field(i)
+		fieldValue := targetElem.Field(i)
+
+		// Look into embedded structs
+		if field.Anonymous && fieldValue.Kind() == reflect.Struct {
+			if err := b.setStructDefaults(fieldValue); err != nil {
+				return err
+			}
+			continue
+		}
+
+		def, ok := field.Tag.Lookup(TagDefault)
+		if !ok || !fieldValue.CanSet() || !fieldValue.IsZero() {
+			continue
+		}
+
+		var errs BindingErrors
+		if fieldValue.Kind() == reflect.Slice || fieldValue.Kind() == reflect.Array {
+			errs = b.setFormValues(fieldValue, field, field.Name, defaultValues(field.Type, def))
+		} else if err := b.setFormValue(fieldValue, def, nil); err != nil {
+			errs = append(errs, &FieldError{Field: field.Name, Value: def, Type: field.Type.String(), Err: err})
+		}
+		if len(errs) > 0 {
+			return errors.Join(ErrInvalidInput, fmt.Errorf("invalid default value: %w", errs))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package binder_test
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestDefaults(t *testing.T) {
+	type Params struct {
+		Limit   int           `query:"limit" form:"limit" header:"X-Limit" json:"limit" default:"20"`
+		Sort    string        `query:"sort" form:"sort" header:"X-Sort" json:"sort" default:"created_at"`
+		Tags    []string      `query:"tags" form:"tags" json:"tags" default:"new,hot"`
+		Timeout time.Duration `query:"timeout" form:"timeout" json:"-" default:"30s"`
+		Page    int           `query:"page" form:"page" header:"X-Page" json:"page"`
+	}
+
+	t.Run("query", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/?page=2&sort=", nil)
+
+		var params Params
+		require.NoError(t, binder.BindQuery(req, &params))
+		require.Equal(t, 20, params.Limit)
+		require.Equal(t, "", params.Sort, "empty values are not absent by default")
+		require.Equal(t, []string{"new", "hot"}, params.Tags)
+		require.Equal(t, 30*time.Second, params.Timeout)
+		require.Equal(t, 2, params.Page)
+		require.Equal(t, "", req.URL.Query().Get("limit"), "request values must be kept intact")
+	})
+
+	t.Run("query with explicit values", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/?limit=5&sort=name&tags=a&timeout=1m", nil)
+
+		var params Params
+		require.NoError(t, binder.BindQuery(req, &params))
+		require.Equal(t, 5, params.Limit)
+		require.Equal(t, "name", params.Sort)
+		require.Equal(t, []string{"a"}, params.Tags)
+		require.Equal(t, time.Minute, params.Timeout)
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/items", nil)
+
+		var params Params
+		require.NoError(t, binder.BindQuery(req, &params))
+		require.Equal(t, 20, params.Limit)
+		require.Equal(t, "created_at", params.Sort)
+		require.Equal(t, 0, params.Page)
+
+		var all Params
+		require.NoError(t, binder.BindAll(req, &all))
+		require.Equal(t, params, all)
+
+		// Nothing to default, so the empty query is still an error
+		var noDefaults struct {
+			Page int `query:"page"`
+		}
+		require.ErrorIs(t, binder.BindQuery(req, &noDefaults), binder.ErrEmptyQuery)
+	})
+
+	t.Run("default on empty", func(t *testing.T) {
+		b := binder.New(binder.WithDefaultOnEmpty(true))
+		req := httptest.NewRequest(http.MethodGet, "/?limit=&sort=&page=", nil)
+
+		var params Params
+		require.NoError(t, b.BindQuery(req, &params))
+		require.Equal(t, 20, params.Limit)
+		require.Equal(t, "created_at", params.Sort)
+		require.Equal(t, 0, params.Page)
+	})
+
+	t.Run("form", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("page=3"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		var params Params
+		require.NoError(t, binder.BindForm(req, &params))
+		require.Equal(t, 20, params.Limit)
+		require.Equal(t, "created_at", params.Sort)
+		require.Equal(t, []string{"new", "hot"}, params.Tags)
+		require.Equal(t, 3, params.Page)
+	})
+
+	t.Run("multipart", func(t *testing.T) {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		require.NoError(t, writer.WriteField("page", "4"))
+		require.NoError(t, writer.WriteField("sort", ""))
+		require.NoError(t, writer.Close())
+		req := httptest.NewRequest(http.MethodPost, "/", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+
+		var params Params
+		require.NoError(t, binder.New(binder.WithDefaultOnEmpty(true)).BindFormMultipart(req, &params))
+		require.Equal(t, 20, params.Limit)
+		require.Equal(t, "created_at", params.Sort)
+		require.Equal(t, []string{"new", "hot"}, params.Tags)
+		require.Equal(t, 30*time.Second, params.Timeout)
+		require.Equal(t, 4, params.Page)
+	})
+
+	t.Run("header", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Sort", "name")
+
+		var params Params
+		require.NoError(t, binder.BindHeader(req, &params))
+		require.Equal(t, 20, params.Limit)
+		require.Equal(t, "name", params.Sort)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"limit":0,"page":1}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		var params Params
+		require.NoError(t, binder.BindJSON(req, &params))
+		require.Equal(t, 0, params.Limit, "explicit zero values must be kept")
+		require.Equal(t, "created_at", params.Sort)
+		require.Equal(t, []string{"new", "hot"}, params.Tags)
+		require.Equal(t, 1, params.Page)
+	})
+
+	t.Run("json keeps preset values", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(`{"page":3}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		// The stored values to be patched must not be reset to the defaults
+		params := Params{Limit: 50, Tags: []string{"old"}}
+		require.NoError(t, binder.BindJSON(req, &params))
+		require.Equal(t, 50, params.Limit)
+		require.Equal(t, []string{"old"}, params.Tags)
+		require.Equal(t, "created_at", params.Sort)
+		require.Equal(t, 3, params.Page)
+	})
+
+	t.Run("invalid default", func(t *testing.T) {
+		var params struct {
+			Limit int `query:"limit" json:"limit" default:"many"`
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/?page=1", nil)
+		err := binder.BindQuery(req, &params)
+		require.ErrorIs(t, err, binder.ErrInvalidValue)
+
+		req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		err = binder.BindJSON(req, &params)
+		require.ErrorIs(t, err, binder.ErrInvalidInput)
+		require.Equal(t, http.StatusInternalServerError, binder.ErrorStatus(err))
+	})
+}
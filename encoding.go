@@ -0,0 +1,247 @@
+package binder
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Content codings of the request body supported by the binder
+const (
+	EncodingGzip    = "gzip"
+	EncodingDeflate = "deflate"
+	EncodingBrotli  = "br"
+	EncodingZstd    = "zstd"
+)
+
+// MaxDecompressedSize is the maximum size of the decompressed request body in bytes.
+// Zero means no limit. Default value is 32 << 20 (32 MB).
+// It is used by binder instances created without WithMaxDecompressedSize option.
+var MaxDecompressedSize int64 = 32 << 20
+
+// MaxDecompressionRatio is the maximum ratio of the decompressed request body size to the compressed one.
+// Zero means no limit. Default value is 100.
+// It is used by binder instances created without WithMaxDecompressionRatio option.
+var MaxDecompressionRatio int64 = 100
+
+// zstdMaxWindow is the maximum window size of the zstd frames the body may use,
+// the one the zstd content coding limits the encoders to, see RFC 9659.
+const zstdMaxWindow = 8 << 20
+
+// ratioMinSize is the decompressed size the decompression ratio is checked from,
+// so small, well-compressible bodies are not rejected.
+const ratioMinSize = 64 << 10
+
+// decompressedLimit returns the maximum size of the decompressed request body in bytes, or zero if there is no limit.
+func (b *Instance) decompressedLimit() int64 {
+	switch {
+	case b.maxDecompressedSize > 0:
+		return b.maxDecompressedSize
+	case b.maxDecompressedSize < 0:
+		return 0
+	default:
+		return MaxDecompressedSize
+	}
+}
+
+// decompressionRatio returns the maximum decompression ratio of the request body, or zero if there is no limit.
+func (b *Instance) decompressionRatio() int64 {
+	switch {
+	case b.maxDecompressionRatio > 0:
+		return b.maxDecompressionRatio
+	case b.maxDecompressionRatio < 0:
+		return 0
+	default:
+		return MaxDecompressionRatio
+	}
+}
+
+// decompressBody replaces the request body with the decompressed one according to the Content-Encoding header.
+// The codings are removed in the reverse order they were applied in, and the header is removed afterwards,
+// as the body is not encoded anymore. The decompressors are created on the first read of the body,
+// so the decoders check the request method and content type first.
+// Reading the body fails with ErrUnsupportedEncoding for unknown codings.
+func (b *Instance) decompressBody(r *http.Request) *decompressReader {
+	codings := contentCodings(r.Header.Values("Content-Encoding"))
+	if len(codings) == 0 {
+		return nil
+	}
+
+	body := &decompressReader{
+		codings:    codings,
+		compressed: &countingReader{r: r.Body},
+		remaining:  b.decompressedLimit(),
+		limit:      b.decompressedLimit(),
+		ratio:      b.decompressionRatio(),
+		closers:    []io.Closer{r.Body},
+	}
+
+	r.Body = body
+	r.ContentLength = -1
+	r.Header.Del("Content-Encoding")
+	return body
+}
+
+// contentCodings returns the lower-cased content codings of the Content-Encoding header values,
+// without the identity coding.
+func contentCodings(values []string) []string {
+	var codings []string
+	for _, value := range values {
+		for _, coding := range strings.Split(value, ",") {
+			coding = strings.ToLower(strings.TrimSpace(coding))
+			if coding != "" && coding != "identity" {
+				codings = append(codings, coding)
+			}
+		}
+	}
+	return codings
+}
+
+// newDecompressor returns the reader decompressing the content coding.
+// The limit is the maximum size of the decompressed body in bytes, or zero if there is no limit,
+// it bounds the memory the decompressor may allocate up front.
+func newDecompressor(coding string, r io.Reader, limit int64) (io.Reader, error) {
+	switch coding {
+	case EncodingGzip, "x-gzip":
+		return gzip.NewReader(r)
+	case EncodingDeflate:
+		// The deflate coding is zlib-wrapped, but some clients send raw deflate data
+		br := bufio.NewReader(r)
+		if header, err := br.Peek(2); err == nil && isZlibHeader(header) {
+			return zlib.NewReader(br)
+		}
+		return flate.NewReader(br), nil
+	case EncodingBrotli:
+		return brotli.NewReader(r), nil
+	case EncodingZstd:
+		// The frames declare their window size, which is allocated before any data is decompressed,
+		// so the window is limited to the decompressed body limit, and the blocks are decoded synchronously
+		window := uint64(zstdMaxWindow)
+		if limit > 0 && uint64(limit) < window {
+			window = max(uint64(limit), zstd.MinWindowSize)
+		}
+		options := []zstd.DOption{zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxWindow(window)}
+		if limit > 0 {
+			options = append(options, zstd.WithDecoderMaxMemory(max(uint64(limit), zstd.MinWindowSize)))
+		}
+		d, err := zstd.NewReader(r, options...)
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedEncoding, coding)
+	}
+}
+
+// isZlibHeader reports whether the first two bytes are the zlib header, see RFC 1950.
+func isZlibHeader(header []byte) bool {
+	return header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0
+}
+
+// countingReader counts the bytes read from the underlying reader.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+// Read implements the io.Reader interface.
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// decompressReader reads the decompressed request body, limiting its size and the decompression ratio.
+// It keeps the error, so it can be reported whatever the decoder does with the read error.
+type decompressReader struct {
+	r          io.Reader
+	codings    []string
+	compressed *countingReader
+	closers    []io.Closer
+	read       int64
+	remaining  int64
+	limit      int64
+	ratio      int64
+	err        error
+}
+
+// open creates the chain of the decompressors of the content codings.
+func (d *decompressReader) open() error {
+	// Check all the codings before reading the body
+	for _, coding := range d.codings {
+		switch coding {
+		case EncodingGzip, "x-gzip", EncodingDeflate, EncodingBrotli, EncodingZstd:
+		default:
+			return fmt.Errorf("%w: %s", ErrUnsupportedEncoding, coding)
+		}
+	}
+
+	var reader io.Reader = d.compressed
+	for i := len(d.codings) - 1; i >= 0; i-- {
+		decompressor, err := newDecompressor(d.codings[i], reader, d.limit)
+		if err != nil {
+			return errors.Join(ErrDecompressBody, err)
+		}
+		if closer, ok := decompressor.(io.Closer); ok {
+			d.closers = append(d.closers, closer)
+		}
+		reader = decompressor
+	}
+	d.r = reader
+	return nil
+}
+
+// Read implements the io.Reader interface.
+// The decompressors are created on the first read.
+func (d *decompressReader) Read(p []byte) (int, error) {
+	if d.r == nil && d.err == nil {
+		d.err = d.open()
+	}
+	if d.err != nil {
+		return 0, d.err
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	// Read one byte more than the limit to find out whether the body exceeds it
+	if d.limit > 0 && int64(len(p))-1 > d.remaining {
+		p = p[:d.remaining+1]
+	}
+	n, err := d.r.Read(p)
+	d.read += int64(n)
+
+	switch {
+	case d.limit > 0 && int64(n) > d.remaining:
+		n = int(d.remaining)
+		d.err = fmt.Errorf("%w: decompressed body limit is %d bytes", ErrBodyTooLarge, d.limit)
+	case d.ratio > 0 && d.read > ratioMinSize && d.read > d.ratio*d.compressed.n:
+		d.err = fmt.Errorf("%w: decompression ratio limit is %d", ErrBodyTooLarge, d.ratio)
+	case err != nil && !errors.Is(err, io.EOF):
+		d.err = errors.Join(ErrDecompressBody, err)
+	default:
+		d.remaining -= int64(n)
+		return n, err
+	}
+	return n, d.err
+}
+
+// Close implements the io.Closer interface.
+// It closes the decompressors and the original request body.
+func (d *decompressReader) Close() error {
+	var errs []error
+	for i := len(d.closers) - 1; i >= 0; i-- {
+		errs = append(errs, d.closers[i].Close())
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,213 @@
+package binder_test
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestContentEncoding(t *testing.T) {
+	type Payload struct {
+		Name string `json:"name" form:"name"`
+	}
+
+	// compress the data with the writer created by newWriter
+	compress := func(data []byte, newWriter func(w io.Writer) io.WriteCloser) []byte {
+		buf := new(bytes.Buffer)
+		w := newWriter(buf)
+		_, err := w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+	gzipWriter := func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) }
+	zlibWriter := func(w io.Writer) io.WriteCloser { return zlib.NewWriter(w) }
+	flateWriter := func(w io.Writer) io.WriteCloser {
+		fw, err := flate.NewWriter(w, flate.BestCompression)
+		require.NoError(t, err)
+		return fw
+	}
+	brotliWriter := func(w io.Writer) io.WriteCloser { return brotli.NewWriter(w) }
+	zstdWriter := func(w io.Writer) io.WriteCloser {
+		zw, err := zstd.NewWriter(w)
+		require.NoError(t, err)
+		return zw
+	}
+
+	payload := []byte(`{"name":"John"}`)
+
+	for _, tc := range []struct {
+		encoding  string
+		newWriter func(w io.Writer) io.WriteCloser
+	}{
+		{"gzip", gzipWriter},
+		{"deflate", zlibWriter},
+		{"deflate", flateWriter},
+		{"br", brotliWriter},
+		{"zstd", zstdWriter},
+	} {
+		t.Run(tc.encoding, func(t *testing.T) {
+			req, err := newBodyRequest(http.MethodPost, "/", "application/json", compress(payload, tc.newWriter), map[string]string{"Content-Encoding": tc.encoding})
+			require.NoError(t, err)
+
+			var p Payload
+			require.NoError(t, binder.BindFunc(req, &p))
+			require.Equal(t, "John", p.Name)
+			require.Empty(t, req.Header.Get("Content-Encoding"))
+		})
+	}
+
+	t.Run("form", func(t *testing.T) {
+		req, err := newBodyRequest(http.MethodPost, "/", "application/x-www-form-urlencoded", compress([]byte("name=Jane"), gzipWriter), map[string]string{"Content-Encoding": "gzip"})
+		require.NoError(t, err)
+
+		var p Payload
+		require.NoError(t, binder.BindForm(req, &p))
+		require.Equal(t, "Jane", p.Name)
+	})
+
+	t.Run("multiple encodings", func(t *testing.T) {
+		body := compress(compress(payload, gzipWriter), brotliWriter)
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", body, map[string]string{"Content-Encoding": "gzip, br"})
+		require.NoError(t, err)
+
+		var p Payload
+		require.NoError(t, binder.BindJSON(req, &p))
+		require.Equal(t, "John", p.Name)
+	})
+
+	t.Run("identity", func(t *testing.T) {
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", payload, map[string]string{"Content-Encoding": "identity"})
+		require.NoError(t, err)
+
+		var p Payload
+		require.NoError(t, binder.BindJSON(req, &p))
+		require.Equal(t, "John", p.Name)
+	})
+
+	t.Run("unsupported encoding", func(t *testing.T) {
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", payload, map[string]string{"Content-Encoding": "compress"})
+		require.NoError(t, err)
+
+		var p Payload
+		err = binder.BindJSON(req, &p)
+		require.ErrorIs(t, err, binder.ErrUnsupportedEncoding)
+		require.Equal(t, http.StatusUnsupportedMediaType, binder.ErrorStatus(err))
+	})
+
+	t.Run("method and content type are checked first", func(t *testing.T) {
+		req, err := newBodyRequest(http.MethodGet, "/", "application/json", payload, map[string]string{"Content-Encoding": "compress"})
+		require.NoError(t, err)
+
+		var p Payload
+		err = binder.BindJSON(req, &p)
+		require.ErrorIs(t, err, binder.ErrInvalidMethod)
+		require.Equal(t, http.StatusMethodNotAllowed, binder.ErrorStatus(err))
+
+		// the body is not read before the checks, so the invalid gzip data is not reported
+		req, err = newBodyRequest(http.MethodPost, "/", "text/plain", payload, map[string]string{"Content-Encoding": "gzip"})
+		require.NoError(t, err)
+		err = binder.BindJSON(req, &p)
+		require.ErrorIs(t, err, binder.ErrInvalidContentType)
+		require.NotErrorIs(t, err, binder.ErrDecompressBody)
+	})
+
+	t.Run("corrupted body", func(t *testing.T) {
+		body := compress(payload, gzipWriter)
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", body[:len(body)/2], map[string]string{"Content-Encoding": "gzip"})
+		require.NoError(t, err)
+
+		var p Payload
+		err = binder.BindJSON(req, &p)
+		require.ErrorIs(t, err, binder.ErrDecompressBody)
+		require.Equal(t, http.StatusBadRequest, binder.ErrorStatus(err))
+
+		req, err = newBodyRequest(http.MethodPost, "/", "application/json", payload, map[string]string{"Content-Encoding": "gzip"})
+		require.NoError(t, err)
+		err = binder.BindJSON(req, &p)
+		require.ErrorIs(t, err, binder.ErrDecompressBody)
+	})
+
+	// a JSON document with a long, well-compressible string
+	bomb := []byte(`{"name":"` + strings.Repeat("a", 1<<20) + `"}`)
+
+	t.Run("decompressed size limit", func(t *testing.T) {
+		b := binder.New(binder.WithMaxDecompressedSize(64<<10), binder.WithMaxDecompressionRatio(-1))
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", compress(bomb, gzipWriter), map[string]string{"Content-Encoding": "gzip"})
+		require.NoError(t, err)
+
+		var p Payload
+		err = b.BindJSON(req, &p)
+		require.ErrorIs(t, err, binder.ErrBodyTooLarge)
+		require.Contains(t, err.Error(), "decompressed body limit")
+		require.Equal(t, http.StatusRequestEntityTooLarge, binder.ErrorStatus(err))
+	})
+
+	t.Run("decompression ratio limit", func(t *testing.T) {
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", compress(bomb, gzipWriter), map[string]string{"Content-Encoding": "gzip"})
+		require.NoError(t, err)
+
+		var p Payload
+		err = binder.BindJSON(req, &p)
+		require.ErrorIs(t, err, binder.ErrBodyTooLarge)
+		require.Contains(t, err.Error(), "decompression ratio")
+	})
+
+	t.Run("limits disabled", func(t *testing.T) {
+		b := binder.New(binder.WithMaxDecompressedSize(-1), binder.WithMaxDecompressionRatio(-1))
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", compress(bomb, gzipWriter), map[string]string{"Content-Encoding": "gzip"})
+		require.NoError(t, err)
+
+		var p Payload
+		require.NoError(t, b.BindJSON(req, &p))
+		require.Len(t, p.Name, 1<<20)
+	})
+
+	t.Run("zstd window size limit", func(t *testing.T) {
+		// The zstd frame declares the 512 MB window and holds a single raw block of one byte
+		frame := []byte{0x28, 0xb5, 0x2f, 0xfd, 0x00, 19 << 3, 0x09, 0x00, 0x00, 'x'}
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", frame, map[string]string{"Content-Encoding": "zstd"})
+		require.NoError(t, err)
+
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		var p Payload
+		err = binder.BindJSON(req, &p)
+		runtime.ReadMemStats(&after)
+		require.ErrorIs(t, err, binder.ErrDecompressBody)
+		require.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(8<<20))
+	})
+
+	t.Run("zstd limits disabled", func(t *testing.T) {
+		b := binder.New(binder.WithMaxDecompressedSize(-1), binder.WithMaxDecompressionRatio(-1))
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", compress(bomb, zstdWriter), map[string]string{"Content-Encoding": "zstd"})
+		require.NoError(t, err)
+
+		var p Payload
+		require.NoError(t, b.BindJSON(req, &p))
+		require.Len(t, p.Name, 1<<20)
+	})
+
+	t.Run("compressed body size limit", func(t *testing.T) {
+		b := binder.New(binder.WithMaxBodySize(16))
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", compress(payload, gzipWriter), map[string]string{"Content-Encoding": "gzip"})
+		require.NoError(t, err)
+		req.ContentLength = -1
+
+		var p Payload
+		err = b.BindJSON(req, &p)
+		require.ErrorIs(t, err, binder.ErrBodyTooLarge)
+	})
+}
@@ -1,6 +1,15 @@
 package binder
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/schema"
+)
 
 // Predefined errors
 var (
@@ -12,6 +21,9 @@ var (
 	ErrGetFile              = errors.New("failed to get file from request")
 	ErrReadFile             = errors.New("failed to read file from request")
 	ErrGetFileMimeType      = errors.New("failed to get file mime type")
+	ErrSaveFile             = errors.New("failed to save file")
+	ErrFileTooLarge         = errors.New("file is too large")
+	ErrInvalidFileType      = errors.New("file type is not allowed")
 	ErrEmptyQuery           = errors.New("empty query string")
 	ErrDecodeQuery          = errors.New("failed to decode request query")
 	ErrInvalidInput         = errors.New("invalid input")
@@ -20,4 +32,212 @@ var (
 	ErrTargetMustBeAStruct  = errors.New("target must be a struct")
 	ErrInputIsNil           = errors.New("input is nil")
 	ErrDecodeJSON           = errors.New("failed to decode json")
+	ErrDecodeXML            = errors.New("failed to decode xml")
+	ErrDecodeYAML           = errors.New("failed to decode yaml")
+	ErrDecodeTOML           = errors.New("failed to decode toml")
+	ErrDecodeMsgPack        = errors.New("failed to decode msgpack")
+	ErrDecodeCBOR           = errors.New("failed to decode cbor")
+	ErrDecodeProto          = errors.New("failed to decode protobuf")
+	ErrTargetMustBeAProto   = errors.New("target must be a protobuf message")
+	ErrDecodePath           = errors.New("failed to decode path parameters")
+	ErrDecodeHeader         = errors.New("failed to decode request headers")
+	ErrDecodeCookie         = errors.New("failed to decode request cookies")
+	ErrInvalidCookieSig     = errors.New("invalid cookie signature")
+	ErrEmptyCookieKey       = errors.New("cookie signing key is not set")
+	ErrValidation           = errors.New("validation failed")
+	ErrBodyTooLarge         = errors.New("request body is too large")
+	ErrUnsupportedEncoding  = errors.New("unsupported content encoding")
+	ErrDecompressBody       = errors.New("failed to decompress request body")
+)
+
+// Field error sentinels, wrapped by FieldError.
+var (
+	ErrInvalidValue = errors.New("invalid value")
+	ErrUnknownField = errors.New("unknown field")
+	ErrMissingField = errors.New("missing")
+)
+
+// Source is the part of the request the value is bound from.
+type Source string
+
+// Binding sources
+const (
+	SourcePath   Source = "path"
+	SourceQuery  Source = "query"
+	SourceHeader Source = "header"
+	SourceCookie Source = "cookie"
+	SourceForm   Source = "form"
+	SourceJSON   Source = "json"
 )
+
+// FieldError describes a failure to bind a single struct field.
+type FieldError struct {
+	// Field is the path of the struct field, e.g. "Address.City" or "Items[0].Name".
+	Field string
+	// Source is the part of the request the value was bound from.
+	Source Source
+	// Tag is the name of the value in the source, e.g. the query parameter or header name.
+	Tag string
+	// Value is the raw value that failed to bind.
+	Value string
+	// Type is the expected type of the value.
+	Type string
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	msg := fmt.Sprintf("%s %q", e.Source, e.Tag)
+	if e.Type != "" {
+		msg += fmt.Sprintf(" (%s)", e.Type)
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Unwrap returns the underlying error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// BindingErrors is a collection of field errors.
+// Binders accumulate every failed field instead of stopping at the first one.
+// Use errors.As to get it from the error returned by the binder.
+type BindingErrors []*FieldError
+
+// Error implements the error interface.
+func (e BindingErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the field errors, so errors.Is and errors.As look into each of them.
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// newBindingErrors converts the gorilla/schema decoding error into binding errors.
+// It returns the passed error as is, if it is not a schema.MultiError.
+func newBindingErrors(err error, source Source, v interface{}, tag string, src map[string][]string) error {
+	var multiErr schema.MultiError
+	if !errors.As(err, &multiErr) {
+		return err
+	}
+
+	// Sort the keys to keep the errors order stable
+	keys := make([]string, 0, len(multiErr))
+	for key := range multiErr {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	t := reflect.TypeOf(v).Elem()
+	errs := make(BindingErrors, 0, len(keys))
+	for _, key := range keys {
+		fieldErr := &FieldError{
+			Field:  fieldPath(t, tag, key),
+			Source: source,
+			Tag:    key,
+			Err:    multiErr[key],
+		}
+		if values := src[key]; len(values) > 0 {
+			fieldErr.Value = values[0]
+		}
+
+		var convErr schema.ConversionError
+		var unknownErr schema.UnknownKeyError
+		var emptyErr schema.EmptyFieldError
+		switch {
+		case errors.As(multiErr[key], &convErr):
+			if convErr.Type != nil {
+				fieldErr.Type = convErr.Type.String()
+			}
+			if convErr.Index >= 0 && convErr.Index < len(src[key]) {
+				fieldErr.Value = src[key][convErr.Index]
+			}
+			fieldErr.Err = ErrInvalidValue
+			if convErr.Err != nil {
+				fieldErr.Err = fmt.Errorf("%w: %w", ErrInvalidValue, convErr.Err)
+			}
+		case errors.As(multiErr[key], &unknownErr):
+			fieldErr.Err = ErrUnknownField
+		case errors.As(multiErr[key], &emptyErr):
+			fieldErr.Err = ErrMissingField
+		}
+
+		errs = append(errs, fieldErr)
+	}
+
+	return errs
+}
+
+// fieldPath returns the path of the struct field for the dotted key of the source map,
+// e.g. "address.city" becomes "Address.City" and "items.0.name" becomes "Items[0].Name".
+// The key is returned as is, if it does not match any field.
+func fieldPath(t reflect.Type, tag, key string) string {
+	var path strings.Builder
+	for _, part := range strings.Split(key, ".") {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array:
+			if _, err := strconv.Atoi(part); err != nil {
+				return key
+			}
+			path.WriteString("[" + part + "]")
+			t = t.Elem()
+		case reflect.Struct:
+			field, ok := fieldByAlias(t, tag, part)
+			if !ok {
+				return key
+			}
+			if path.Len() > 0 {
+				path.WriteString(".")
+			}
+			path.WriteString(field.Name)
+			t = field.Type
+		default:
+			return key
+		}
+	}
+	return path.String()
+}
+
+// fieldByAlias returns the struct field with the given tag name.
+// The field name is used if the field has no tag, the same way as gorilla/schema does.
+func fieldByAlias(t reflect.Type, tag, alias string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get(tag), ",")[0]
+		if name == "" {
+			if field.Anonymous {
+				ft := field.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct {
+					if f, ok := fieldByAlias(ft, tag, alias); ok {
+						return f, true
+					}
+				}
+			}
+			name = field.Name
+		}
+		if strings.EqualFold(name, alias) {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,130 @@
+package binder_test
+
+import (
+	"bytes"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestBindingErrors(t *testing.T) {
+	t.Run("query", func(t *testing.T) {
+		type Filter struct {
+			Page  int  `query:"page"`
+			Limit int  `query:"limit"`
+			Paid  bool `query:"paid"`
+			Range struct {
+				From int `query:"from"`
+			} `query:"range"`
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/?page=one&limit=10&paid=maybe&range.from=x", nil)
+
+		var filter Filter
+		err := binder.BindQuery(req, &filter)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrDecodeQuery)
+		require.ErrorIs(t, err, binder.ErrInvalidValue)
+
+		var errs binder.BindingErrors
+		require.True(t, errors.As(err, &errs))
+		require.Len(t, errs, 3)
+
+		require.Equal(t, "Page", errs[0].Field)
+		require.Equal(t, binder.SourceQuery, errs[0].Source)
+		require.Equal(t, "page", errs[0].Tag)
+		require.Equal(t, "one", errs[0].Value)
+		require.Equal(t, "int", errs[0].Type)
+
+		require.Equal(t, "Paid", errs[1].Field)
+		require.Equal(t, "maybe", errs[1].Value)
+		require.Equal(t, "bool", errs[1].Type)
+
+		require.Equal(t, "Range.From", errs[2].Field)
+		require.Equal(t, "range.from", errs[2].Tag)
+		require.Equal(t, "x", errs[2].Value)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		type Filter struct {
+			Page int `query:"page"`
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/?page=1&sort=name", nil)
+
+		var filter Filter
+		err := binder.New(binder.WithIgnoreUnknownKeys(false)).BindQuery(req, &filter)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrUnknownField)
+
+		var errs binder.BindingErrors
+		require.True(t, errors.As(err, &errs))
+		require.Len(t, errs, 1)
+		require.Equal(t, "sort", errs[0].Tag)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		type Payload struct {
+			Name    string `json:"name"`
+			Address struct {
+				Zip int `json:"zip"`
+			} `json:"address"`
+		}
+
+		req, err := newJSONRequest(http.MethodPost, "/", map[string]interface{}{
+			"name":    "john",
+			"address": map[string]interface{}{"zip": "abc"},
+		}, nil)
+		require.NoError(t, err)
+
+		var payload Payload
+		err = binder.BindJSON(req, &payload)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrDecodeJSON)
+
+		var fieldErr *binder.FieldError
+		require.True(t, errors.As(err, &fieldErr))
+		require.Equal(t, "Address.Zip", fieldErr.Field)
+		require.Equal(t, binder.SourceJSON, fieldErr.Source)
+		require.Equal(t, "address.zip", fieldErr.Tag)
+		require.Equal(t, "int", fieldErr.Type)
+	})
+
+	t.Run("multipart accumulates errors", func(t *testing.T) {
+		type Payload struct {
+			Age    int     `form:"age"`
+			Score  float64 `form:"score"`
+			Active bool    `form:"active"`
+		}
+
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		require.NoError(t, writer.WriteField("age", "old"))
+		require.NoError(t, writer.WriteField("score", "high"))
+		require.NoError(t, writer.WriteField("active", "true"))
+		require.NoError(t, writer.Close())
+
+		req := httptest.NewRequest(http.MethodPost, "/", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+
+		var payload Payload
+		err := binder.BindFormMultipart(req, &payload)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrDecodeForm)
+
+		var errs binder.BindingErrors
+		require.True(t, errors.As(err, &errs))
+		require.Len(t, errs, 2)
+		require.Equal(t, "Age", errs[0].Field)
+		require.Equal(t, "old", errs[0].Value)
+		require.Equal(t, "Score", errs[1].Field)
+		require.Equal(t, "high", errs[1].Value)
+		require.True(t, payload.Active)
+	})
+}
@@ -0,0 +1,174 @@
+package binder
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileStore is the interface implemented by storage sinks for uploaded files.
+// When a file store is set with WithFileStore, BindFormMultipart copies every uploaded file
+// into the store and sets the storage key of the bound File instead of its Data.
+type FileStore interface {
+	// Save stores the file contents read from r and returns the storage key of the file.
+	Save(ctx context.Context, name, contentType string, r io.Reader) (string, error)
+	// Delete removes the stored file by its key.
+	// The binder calls it to clean up the stored files when binding fails.
+	Delete(ctx context.Context, key string) error
+}
+
+// LocalFileStore stores uploaded files in a local directory.
+type LocalFileStore struct {
+	dir string
+}
+
+// NewLocalFileStore creates a new file store in the given directory.
+// If dir is empty, the default directory for temporary files is used, see os.TempDir.
+func NewLocalFileStore(dir string) *LocalFileStore {
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	return &LocalFileStore{dir: dir}
+}
+
+// Save implements the FileStore interface.
+// It writes the file to a new file with a unique name in the store directory,
+// the name is returned as the storage key. The partially written file is removed on error.
+func (s *LocalFileStore) Save(_ context.Context, name, _ string, r io.Reader) (string, error) {
+	f, err := os.CreateTemp(s.dir, "upload-*"+filepath.Ext(name))
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+
+	return filepath.Base(f.Name()), nil
+}
+
+// Delete implements the FileStore interface.
+func (s *LocalFileStore) Delete(_ context.Context, key string) error {
+	return os.Remove(s.Path(key))
+}
+
+// Open opens the stored file by its key.
+func (s *LocalFileStore) Open(key string) (*os.File, error) {
+	return os.Open(s.Path(key))
+}
+
+// Path returns the path of the stored file by its key.
+func (s *LocalFileStore) Path(key string) string {
+	return filepath.Join(s.dir, filepath.Base(key))
+}
+
+// MemoryFileStore stores uploaded files in memory.
+// It is useful for tests and small files. It is safe for concurrent use.
+type MemoryFileStore struct {
+	mu    sync.RWMutex
+	seq   int
+	files map[string][]byte
+}
+
+// NewMemoryFileStore creates a new in-memory file store.
+func NewMemoryFileStore() *MemoryFileStore {
+	return &MemoryFileStore{files: make(map[string][]byte)}
+}
+
+// Save implements the FileStore interface.
+func (s *MemoryFileStore) Save(_ context.Context, name, _ string, r io.Reader) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seq++
+	key := fmt.Sprintf("%d-%s", s.seq, filepath.Base(name))
+	s.files[key] = data
+
+	return key, nil
+}
+
+// Delete implements the FileStore interface.
+func (s *MemoryFileStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.files[key]; !ok {
+		return os.ErrNotExist
+	}
+	delete(s.files, key)
+	return nil
+}
+
+// Open returns the reader of the stored file by its key.
+func (s *MemoryFileStore) Open(key string) (io.Reader, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, ok := s.files[key]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return bytes.NewReader(data), nil
+}
+
+// Len returns the number of stored files.
+func (s *MemoryFileStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.files)
+}
+
+// storedFiles collects the keys of the files saved to the file store during a binding call.
+type storedFiles struct {
+	keys []string
+}
+
+// trackStoredFiles returns a copy of the binder that records the keys of the files it saves to the file store,
+// so they can be deleted with deleteStoredFiles. The binder itself is returned if there is no file store
+// or it already records the saved files.
+func (b *Instance) trackStoredFiles() *Instance {
+	if b.fileStore == nil || b.stored != nil {
+		return b
+	}
+	c := *b
+	c.stored = &storedFiles{}
+	return &c
+}
+
+// saveFile saves the file contents read from r to the file store and records its key.
+func (b *Instance) saveFile(ctx context.Context, name, contentType string, r io.Reader) (string, error) {
+	key, err := b.fileStore.Save(ctx, name, contentType, r)
+	if err != nil {
+		return "", err
+	}
+	if b.stored != nil {
+		b.stored.keys = append(b.stored.keys, key)
+	}
+	return key, nil
+}
+
+// deleteStoredFiles removes the files saved to the file store during the binding call, see trackStoredFiles.
+// It is called to clean up when binding or validation fails.
+// The keys of the bound File values are not used, as they may come from the client, e.g. in a JSON body.
+func (b *Instance) deleteStoredFiles(ctx context.Context) {
+	if b.stored == nil {
+		return
+	}
+	for _, key := range b.stored.keys {
+		_ = b.fileStore.Delete(ctx, key)
+	}
+	b.stored.keys = nil
+}
@@ -0,0 +1,168 @@
+package binder_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestFileStore(t *testing.T) {
+	testImage, err := os.ReadFile("testdata/test.jpg")
+	require.NoError(t, err)
+
+	// the multipart form has the age field and the avatar file
+	avatar := []multipartFile{{"avatar", "test.jpg", testImage}}
+
+	type Form struct {
+		Age    int          `form:"age"`
+		Avatar *binder.File `form:"avatar"`
+	}
+
+	t.Run("local store", func(t *testing.T) {
+		dir := t.TempDir()
+		store := binder.NewLocalFileStore(dir)
+		b := binder.New(binder.WithFileStore(store))
+
+		var form Form
+		req, err := newMultipartRequest(http.MethodPost, "/upload", [][2]string{{"age", "30"}}, avatar, nil)
+		require.NoError(t, err)
+		err = b.BindFormMultipart(req, &form)
+		require.NoError(t, err)
+		require.Equal(t, 30, form.Age)
+		require.NotNil(t, form.Avatar)
+		require.Equal(t, "test.jpg", form.Avatar.FileName)
+		require.Equal(t, "image/jpeg", form.Avatar.ContentType)
+		require.Equal(t, int64(len(testImage)), form.Avatar.FileSize)
+		require.Empty(t, form.Avatar.Data)
+		require.NotEmpty(t, form.Avatar.Key)
+
+		data, err := os.ReadFile(store.Path(form.Avatar.Key))
+		require.NoError(t, err)
+		require.Equal(t, testImage, data)
+	})
+
+	t.Run("memory store", func(t *testing.T) {
+		store := binder.NewMemoryFileStore()
+		b := binder.New(binder.WithFileStore(store))
+
+		var form Form
+		req, err := newMultipartRequest(http.MethodPost, "/upload", [][2]string{{"age", "30"}}, avatar, nil)
+		require.NoError(t, err)
+		err = b.Bind(req, &form)
+		require.NoError(t, err)
+		require.NotNil(t, form.Avatar)
+
+		r, err := store.Open(form.Avatar.Key)
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, testImage, data)
+	})
+
+	t.Run("cleanup on binding error", func(t *testing.T) {
+		dir := t.TempDir()
+		b := binder.New(binder.WithFileStore(binder.NewLocalFileStore(dir)))
+
+		var form Form
+		req, err := newMultipartRequest(http.MethodPost, "/upload", [][2]string{{"age", "old"}}, avatar, nil)
+		require.NoError(t, err)
+		err = b.BindFormMultipart(req, &form)
+		require.ErrorIs(t, err, binder.ErrDecodeForm)
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("cleanup on validation error", func(t *testing.T) {
+		store := binder.NewMemoryFileStore()
+		b := binder.New(
+			binder.WithFileStore(store),
+			binder.WithValidator(binder.ValidatorFunc(func(v interface{}) error {
+				return errors.New("too young")
+			})),
+		)
+
+		var form Form
+		req, err := newMultipartRequest(http.MethodPost, "/upload", [][2]string{{"age", "12"}}, avatar, nil)
+		require.NoError(t, err)
+		err = b.Bind(req, &form)
+		require.ErrorIs(t, err, binder.ErrValidation)
+		require.Equal(t, 0, store.Len())
+	})
+
+	t.Run("upload fields are not stored", func(t *testing.T) {
+		store := binder.NewMemoryFileStore()
+		b := binder.New(binder.WithFileStore(store))
+
+		var form struct {
+			Age    int            `form:"age"`
+			Avatar *binder.Upload `form:"avatar"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", [][2]string{{"age", "30"}}, avatar, nil)
+		require.NoError(t, err)
+		err = b.Bind(req, &form)
+		require.ErrorIs(t, err, binder.ErrInvalidInput)
+		require.Nil(t, form.Avatar)
+		require.Equal(t, 0, store.Len())
+	})
+
+	t.Run("cleanup on error after body binding", func(t *testing.T) {
+		store := binder.NewMemoryFileStore()
+		b := binder.New(binder.WithFileStore(store))
+
+		var form struct {
+			Avatar *binder.File `form:"avatar"`
+			Limit  int          `query:"limit"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload?limit=ten", [][2]string{{"age", "30"}}, avatar, nil)
+		require.NoError(t, err)
+		err = b.BindAll(req, &form)
+		require.ErrorIs(t, err, binder.ErrDecodeQuery)
+		require.NotNil(t, form.Avatar)
+		require.Equal(t, 0, store.Len())
+	})
+
+	t.Run("client keys are not deleted", func(t *testing.T) {
+		store := binder.NewMemoryFileStore()
+		key, err := store.Save(context.Background(), "other.jpg", "image/jpeg", bytes.NewReader(testImage))
+		require.NoError(t, err)
+		b := binder.New(
+			binder.WithFileStore(store),
+			binder.WithValidator(binder.ValidatorFunc(func(v interface{}) error {
+				return errors.New("invalid avatar")
+			})),
+		)
+
+		var form struct {
+			Avatar *binder.File `json:"avatar"`
+		}
+		req, err := newJSONRequest(http.MethodPost, "/upload", map[string]interface{}{"avatar": map[string]string{"Key": key}}, nil)
+		require.NoError(t, err)
+		err = b.Bind(req, &form)
+		require.ErrorIs(t, err, binder.ErrValidation)
+		require.Equal(t, key, form.Avatar.Key)
+		require.Equal(t, 1, store.Len())
+
+		_, err = store.Open(key)
+		require.NoError(t, err)
+	})
+
+	t.Run("save error", func(t *testing.T) {
+		b := binder.New(binder.WithFileStore(binder.NewLocalFileStore("/nonexistent/dir")))
+
+		var form Form
+		req, err := newMultipartRequest(http.MethodPost, "/upload", [][2]string{{"age", "30"}}, avatar, nil)
+		require.NoError(t, err)
+		err = b.BindFormMultipart(req, &form)
+		require.ErrorIs(t, err, binder.ErrSaveFile)
+	})
+}
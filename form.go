@@ -6,11 +6,27 @@ import (
 	"net/http"
 )
 
+// BindForm binds the passed v pointer to the request using the default binder instance.
+// See Instance.BindForm for details.
+// Implements the binder.BinderFunc interface.
+func BindForm(r *http.Request, v interface{}) error {
+	return defaultInstance.BindForm(r, v)
+}
+
 // BindForm binds the passed v pointer to the request.
 // It uses the application/x-www-form-urlencoded content type for binding.
 // `v` param should be a pointer to a struct with `form“ tags.
-// Implements the binder.BinderFunc interface.
-func BindForm(r *http.Request, v interface{}) error {
+// The time values are parsed the same way as in BindQuery.
+// The bound value is validated afterwards, see WithValidator.
+func (b *Instance) BindForm(r *http.Request, v interface{}) error {
+	if err := b.decodeBody(r, v, b.bindForm); err != nil {
+		return err
+	}
+	return b.validate(v)
+}
+
+// bindForm binds the passed v pointer to the request form without validation.
+func (b *Instance) bindForm(r *http.Request, v interface{}) error {
 	// Check if the request method is POST, PUT or PATCH
 	if !isPostPutPatch(r) {
 		return fmt.Errorf("%w: %s", ErrInvalidMethod, r.Method)
@@ -37,7 +53,7 @@ func BindForm(r *http.Request, v interface{}) error {
 	}
 
 	// Decode the request body into the v pointer
-	if err := formDecoder.Decode(v, r.PostForm); err != nil {
+	if err := b.decodeValues(b.formDecoder, v, b.formTag, SourceForm, r.PostForm); err != nil {
 		return errors.Join(ErrDecodeForm, err)
 	}
 
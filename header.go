@@ -0,0 +1,93 @@
+package binder
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// BindHeader binds the passed v pointer to the request headers using the default binder instance.
+// See Instance.BindHeader for details.
+// Implements the binder.BinderFunc interface.
+func BindHeader(r *http.Request, v interface{}) error {
+	return defaultInstance.BindHeader(r, v)
+}
+
+// BindHeader binds the passed v pointer to the request headers.
+// `v` param should be a pointer to a struct with `header` tags, e.g. `header:"X-Tenant-ID"`.
+// Header names are canonicalized, so the tag is case-insensitive.
+// Repeated headers and comma-separated header lists are bound into slices.
+// Values are converted the same way as in BindQuery.
+// The bound value is validated afterwards, see WithValidator.
+func (b *Instance) BindHeader(r *http.Request, v interface{}) error {
+	if err := b.bindHeader(r, v); err != nil {
+		return err
+	}
+	return b.validate(v)
+}
+
+// bindHeader binds the passed v pointer to the request headers without validation.
+func (b *Instance) bindHeader(r *http.Request, v interface{}) error {
+	// Validate v pointer before decoding headers into it
+	if !isPointer(v) {
+		return errors.Join(ErrInvalidInput, ErrTargetMustBeAPointer)
+	}
+
+	// Collect the headers referenced by the struct tags
+	headers := make(map[string][]string)
+	for _, field := range taggedFields(v, b.headerTag) {
+		values := r.Header.Values(field.name)
+		if len(values) == 0 {
+			continue
+		}
+		if field.typ.Kind() == reflect.Slice {
+			values = splitHeaderValues(values)
+		}
+		headers[field.name] = values
+	}
+
+	// Decode the headers into the v pointer and handle decoding errors
+	if err := b.decodeValues(b.headerDecoder, v, b.headerTag, SourceHeader, headers); err != nil {
+		return errors.Join(ErrDecodeHeader, err)
+	}
+
+	return nil
+}
+
+// splitHeaderValues splits comma-separated header lists into separate values.
+// The commas inside quoted strings, e.g. in the entity-tags of If-Match, do not separate the values,
+// see RFC 9110, section 5.6.1.
+func splitHeaderValues(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		start := 0
+		quoted := false
+		for i := 0; i < len(value); i++ {
+			switch value[i] {
+			case '\\':
+				// Skip the quoted-pair within the quoted string
+				if quoted {
+					i++
+				}
+			case '"':
+				quoted = !quoted
+			case ',':
+				if !quoted {
+					result = appendHeaderValue(result, value[start:i])
+					start = i + 1
+				}
+			}
+		}
+		result = appendHeaderValue(result, value[start:])
+	}
+	return result
+}
+
+// appendHeaderValue appends the trimmed header list element to the values, skipping the empty ones.
+func appendHeaderValue(values []string, value string) []string {
+	if value = strings.TrimSpace(value); value != "" {
+		values = append(values, value)
+	}
+	return values
+}
@@ -0,0 +1,78 @@
+package binder_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestBindHeader(t *testing.T) {
+	type Headers struct {
+		RequestID string   `header:"X-Request-ID"`
+		TenantID  int64    `header:"x-tenant-id"`
+		IfMatch   []string `header:"If-Match"`
+		Languages []string `header:"Accept-Language"`
+		Debug     bool     `header:"X-Debug"`
+	}
+
+	t.Run("success", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Request-Id", "abc-123")
+		req.Header.Set("X-Tenant-Id", "42")
+		req.Header.Add("If-Match", `"v1"`)
+		req.Header.Add("If-Match", `"v2"`)
+		req.Header.Set("Accept-Language", "en-US, uk;q=0.9")
+		req.Header.Set("X-Debug", "true")
+
+		var headers Headers
+		err := binder.BindHeader(req, &headers)
+		require.NoError(t, err)
+		require.Equal(t, "abc-123", headers.RequestID)
+		require.Equal(t, int64(42), headers.TenantID)
+		require.Equal(t, []string{`"v1"`, `"v2"`}, headers.IfMatch)
+		require.Equal(t, []string{"en-US", "uk;q=0.9"}, headers.Languages)
+		require.True(t, headers.Debug)
+	})
+
+	t.Run("quoted commas", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("If-Match", `"v1,2", W/"v3", "a\"b,c"`)
+
+		var headers Headers
+		err := binder.BindHeader(req, &headers)
+		require.NoError(t, err)
+		require.Equal(t, []string{`"v1,2"`, `W/"v3"`, `"a\"b,c"`}, headers.IfMatch)
+	})
+
+	t.Run("missing headers", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		var headers Headers
+		err := binder.BindHeader(req, &headers)
+		require.NoError(t, err)
+		require.Equal(t, Headers{}, headers)
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Tenant-Id", "acme")
+
+		var headers Headers
+		err := binder.BindHeader(req, &headers)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrDecodeHeader)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		var headers Headers
+		err := binder.BindHeader(req, headers)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrInvalidInput)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strings"
@@ -87,3 +88,66 @@ func newFormRequest(method, endpoint string, body map[string]interface{}, header
 
 	return req, nil
 }
+
+// new request with the raw body of the content type and headers
+func newBodyRequest(method, url, contentType string, body []byte, headers map[string]string) (*http.Request, error) {
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	return req, nil
+}
+
+// new request with the raw body of unknown length, as if it was sent with the chunked transfer encoding
+func newChunkedRequest(method, url, contentType string, body []byte, headers map[string]string) (*http.Request, error) {
+	req, err := newBodyRequest(method, url, contentType, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = -1
+
+	return req, nil
+}
+
+// multipartFile is the file of the multipart form request
+type multipartFile struct {
+	field   string
+	name    string
+	content []byte
+}
+
+// new multipart form request with the fields, files and headers.
+// The fields are written in order before the files.
+func newMultipartRequest(method, url string, fields [][2]string, files []multipartFile, headers map[string]string) (
+	*http.Request, error,
+) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	for _, field := range fields {
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return nil, err
+		}
+	}
+	for _, file := range files {
+		part, err := writer.CreateFormFile(file.field, file.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := part.Write(file.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return newBodyRequest(method, url, writer.FormDataContentType(), body.Bytes(), headers)
+}
@@ -0,0 +1,183 @@
+package binder
+
+import (
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/gorilla/schema"
+)
+
+// Instance is a configurable implementation of the Binder interface.
+// Unlike the package-level functions, every instance owns its own decoders,
+// tag names and limits, so several services in one binary can use different settings.
+// An instance is safe for concurrent use once it has been created.
+type Instance struct {
+	// formTag is the struct tag name used for form and multipart binding.
+	formTag string
+	// queryTag is the struct tag name used for query binding.
+	queryTag string
+	// pathTag is the struct tag name used for path parameter binding.
+	pathTag string
+	// pathExtractor extracts path parameters from the request.
+	pathExtractor PathParamExtractor
+	// headerTag is the struct tag name used for header binding.
+	headerTag string
+	// cookieTag is the struct tag name used for cookie binding.
+	cookieTag string
+	// cookieKey is the key used to verify signed cookies.
+	cookieKey []byte
+	// ignoreUnknownKeys reports whether unknown keys in the query or form are ignored.
+	ignoreUnknownKeys bool
+	// zeroEmpty reports whether empty values set the zero value of the field.
+	zeroEmpty bool
+	// decoders maps media types to the request body decoders.
+	decoders *decoderRegistry
+	// accept is the list of media types of the request body allowed for binding.
+	// Any registered media type is allowed if it is empty.
+	accept []string
+	// fileStore stores the files uploaded via multipart forms.
+	fileStore FileStore
+	// stored records the files saved to the file store during the binding call, see trackStoredFiles.
+	stored *storedFiles
+	// validator validates the bound values.
+	validator Validator
+	// multipartMaxMemory is the maximum amount of memory to use when parsing a multipart form.
+	// If it is zero, the package-level MultiPartFormMaxMemory is used.
+	multipartMaxMemory int64
+	// converters maps types to the converters registered with RegisterConverter.
+	converters map[reflect.Type]Converter
+	// defaultOnEmpty reports whether the default values are set for the empty values as well as for the absent ones.
+	defaultOnEmpty bool
+	// keepBound reports whether the default values are skipped for the fields bound from the earlier sources,
+	// it is set for the binding calls of BindAll only.
+	keepBound bool
+	// strictJSON reports whether the JSON body is decoded in the strict mode.
+	strictJSON bool
+	// maxBodySize is the maximum size of the request body in bytes.
+	// If it is zero, the package-level MaxBodySize is used, if it is negative, the body size is not limited.
+	maxBodySize int64
+	// maxDecompressedSize is the maximum size of the decompressed request body in bytes.
+	// If it is zero, the package-level MaxDecompressedSize is used, if it is negative, the size is not limited.
+	maxDecompressedSize int64
+	// maxDecompressionRatio is the maximum decompression ratio of the request body.
+	// If it is zero, the package-level MaxDecompressionRatio is used, if it is negative, the ratio is not limited.
+	maxDecompressionRatio int64
+	// location is the time zone of the time values without an explicit zone.
+	location *time.Location
+
+	// formDecoder decodes form data. It uses the gorilla/schema package.
+	formDecoder *schema.Decoder
+	// queryDecoder decodes query data. It uses the gorilla/schema package.
+	queryDecoder *schema.Decoder
+	// pathDecoder decodes path parameters. It uses the gorilla/schema package.
+	pathDecoder *schema.Decoder
+	// headerDecoder decodes request headers. It uses the gorilla/schema package.
+	headerDecoder *schema.Decoder
+	// cookieDecoder decodes request cookies. It uses the gorilla/schema package.
+	cookieDecoder *schema.Decoder
+}
+
+// defaultInstance is used by the package-level binding functions.
+var defaultInstance = New()
+
+// New creates a new binder instance with the given options.
+// By default, it ignores unknown keys, sets zero values for empty fields, parses time values in UTC,
+// uses the TagForm, TagQuery, TagPath, TagHeader and TagCookie tag names and the ServeMuxExtractor for path parameters.
+func New(opts ...Option) *Instance {
+	b := &Instance{
+		formTag:           TagForm,
+		queryTag:          TagQuery,
+		pathTag:           TagPath,
+		pathExtractor:     ServeMuxExtractor{},
+		headerTag:         TagHeader,
+		cookieTag:         TagCookie,
+		ignoreUnknownKeys: true,
+		zeroEmpty:         true,
+		location:          time.UTC,
+		converters:        make(map[reflect.Type]Converter),
+	}
+	b.decoders = &decoderRegistry{decoders: map[string]BodyDecoder{
+		MIMEApplicationJSON:       b.builtin((*Instance).bindJSON),
+		MIMEApplicationXML:        b.builtin((*Instance).bindXML),
+		MIMETextXML:               b.builtin((*Instance).bindXML),
+		MIMEApplicationForm:       b.builtin((*Instance).bindForm),
+		MIMEMultipartForm:         b.builtin((*Instance).bindFormMultipart),
+		MIMEApplicationYAML:       b.builtin((*Instance).bindYAML),
+		MIMEApplicationXYAML:      b.builtin((*Instance).bindYAML),
+		MIMETextYAML:              b.builtin((*Instance).bindYAML),
+		MIMEApplicationTOML:       b.builtin((*Instance).bindTOML),
+		MIMEApplicationMsgPack:    b.builtin((*Instance).bindMsgPack),
+		MIMEApplicationXMsgPack:   b.builtin((*Instance).bindMsgPack),
+		MIMEApplicationVndMsgPack: b.builtin((*Instance).bindMsgPack),
+		MIMEApplicationCBOR:       b.builtin((*Instance).bindCBOR),
+		MIMEApplicationXProtobuf:  b.builtin((*Instance).bindProto),
+		MIMEApplicationProtobuf:   b.builtin((*Instance).bindProto),
+	}}
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	b.formDecoder = b.newDecoder(b.formTag)
+	b.formDecoder.RegisterConverter(FileData{}, FileDataConverter)
+	b.formDecoder.RegisterConverter(&FileData{}, FileDataConverterPtr)
+
+	b.queryDecoder = b.newDecoder(b.queryTag)
+	b.pathDecoder = b.newDecoder(b.pathTag)
+	b.headerDecoder = b.newDecoder(b.headerTag)
+	b.cookieDecoder = b.newDecoder(b.cookieTag)
+
+	return b
+}
+
+// Bind binds the passed v pointer to the request.
+// Binding depends on the request method and the content type, see BindFunc for details.
+// The request body is decoded with the decoder registered for its media type, see RegisterDecoder.
+// Bind implements the Binder interface.
+func (b *Instance) Bind(r *http.Request, v interface{}) error {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete, http.MethodOptions:
+		return b.BindQuery(r, v)
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		b = b.trackStoredFiles()
+		d, err := b.bodyDecoder(r)
+		if err != nil {
+			return err
+		}
+		if err := b.decodeBody(r, v, d.Decode); err != nil {
+			return err
+		}
+		if err := b.validate(v); err != nil {
+			b.deleteStoredFiles(r.Context())
+			return err
+		}
+		return nil
+	default:
+		return ErrInvalidMethod
+	}
+}
+
+// newDecoder creates a new gorilla/schema decoder for the given tag name.
+// It caches meta-data about structs, and an instance can be shared safely.
+func (b *Instance) newDecoder(tag string) *schema.Decoder {
+	d := schema.NewDecoder()
+	d.IgnoreUnknownKeys(b.ignoreUnknownKeys)
+	d.ZeroEmpty(b.zeroEmpty)
+	d.SetAliasTag(tag)
+	d.RegisterConverter(time.Time{}, b.convertTime)
+	d.RegisterConverter(time.Duration(0), convertDuration)
+	return d
+}
+
+// schemaDecoders returns the gorilla/schema decoders of the binder.
+func (b *Instance) schemaDecoders() []*schema.Decoder {
+	return []*schema.Decoder{b.formDecoder, b.queryDecoder, b.pathDecoder, b.headerDecoder, b.cookieDecoder}
+}
+
+// maxMemory returns the maximum amount of memory to use when parsing a multipart form.
+func (b *Instance) maxMemory() int64 {
+	if b.multipartMaxMemory > 0 {
+		return b.multipartMaxMemory
+	}
+	return MultiPartFormMaxMemory
+}
@@ -0,0 +1,91 @@
+package binder_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestNew(t *testing.T) {
+	// Check that the instance implements the Binder interface
+	var _ binder.Binder = binder.New()
+
+	t.Run("custom query tag", func(t *testing.T) {
+		type Params struct {
+			ID       int    `q:"id"`
+			FullName string `query:"name"`
+		}
+
+		req, err := newQueryRequest(http.MethodGet, "/users", map[string]interface{}{
+			"id":   42,
+			"name": "john",
+		}, nil)
+		require.NoError(t, err)
+
+		var params Params
+		err = binder.New(binder.WithQueryTag("q")).BindQuery(req, &params)
+		require.NoError(t, err)
+		require.Equal(t, 42, params.ID)
+		require.Equal(t, "", params.FullName)
+	})
+
+	t.Run("custom form tag", func(t *testing.T) {
+		type Payload struct {
+			Name string `f:"name"`
+		}
+
+		req, err := newFormRequest(http.MethodPost, "/users", map[string]interface{}{
+			"name": "john",
+		}, nil)
+		require.NoError(t, err)
+
+		var payload Payload
+		err = binder.New(binder.WithFormTag("f")).Bind(req, &payload)
+		require.NoError(t, err)
+		require.Equal(t, "john", payload.Name)
+	})
+
+	t.Run("disallow unknown keys", func(t *testing.T) {
+		type Params struct {
+			ID int `query:"id"`
+		}
+
+		req, err := newQueryRequest(http.MethodGet, "/users", map[string]interface{}{
+			"id":      42,
+			"unknown": "value",
+		}, nil)
+		require.NoError(t, err)
+
+		var params Params
+		err = binder.New(binder.WithIgnoreUnknownKeys(false)).BindQuery(req, &params)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrDecodeQuery)
+
+		// The default instance is not affected by the other instance settings
+		err = binder.BindQuery(req, &params)
+		require.NoError(t, err)
+		require.Equal(t, 42, params.ID)
+	})
+
+	t.Run("keep value of empty field", func(t *testing.T) {
+		type Params struct {
+			ID   int    `query:"id"`
+			Sort string `query:"sort"`
+		}
+
+		req, err := newQueryRequest(http.MethodGet, "/users", map[string]interface{}{
+			"id":   42,
+			"sort": "",
+		}, nil)
+		require.NoError(t, err)
+
+		params := Params{Sort: "name"}
+		err = binder.New(binder.WithZeroEmpty(false)).BindQuery(req, &params)
+		require.NoError(t, err)
+		require.Equal(t, 42, params.ID)
+		require.Equal(t, "name", params.Sort)
+	})
+}
@@ -1,41 +1,246 @@
 package binder
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
 )
 
+// BindJSON binds the passed v pointer to the request using the default binder instance.
+// See Instance.BindJSON for details.
+// Implements the binder.BinderFunc interface.
+func BindJSON(r *http.Request, v interface{}) error {
+	return defaultInstance.BindJSON(r, v)
+}
+
 // BindJSON binds the passed v pointer to the request.
 // It uses the JSON content type for binding.
 // `v` param should be a pointer to a struct with `json` tags.
-// Implements the binder.BinderFunc interface.
-func BindJSON(r *http.Request, v interface{}) error {
-	// Check if the request method is POST, PUT or PATCH
-	if !isPostPutPatch(r) {
-		return fmt.Errorf("%w: %s", ErrInvalidMethod, r.Method)
+// The fields absent in the body are set to the value of the `default` struct tag, if any.
+// If v is a protobuf message, it is decoded with the protojson semantics.
+// In the strict mode unknown fields and trailing data are rejected, see WithStrictJSON.
+// The bound value is validated afterwards, see WithValidator.
+func (b *Instance) BindJSON(r *http.Request, v interface{}) error {
+	if err := b.decodeBody(r, v, b.bindJSON); err != nil {
+		return err
+	}
+	return b.validate(v)
+}
+
+// bindJSON binds the passed v pointer to the request JSON body without validation.
+func (b *Instance) bindJSON(r *http.Request, v interface{}) error {
+	// Check the request and the v pointer before decoding the body into it
+	if err := checkBodyRequest(r, v, MIMEApplicationJSON); err != nil {
+		return err
 	}
 
-	// Check if the request content type is JSON
-	if !isJSON(r) {
-		return fmt.Errorf("%w: %s", ErrInvalidContentType, r.Header.Get("Content-Type"))
+	// Decode protobuf messages with the protojson semantics
+	if msg, ok := v.(proto.Message); ok {
+		return decodeProtoJSON(r, msg, b.strictJSON)
 	}
 
-	// Validate v pointer before decoding query into it
-	if !isPointer(v) {
-		return errors.Join(ErrInvalidInput, ErrTargetMustBeAPointer)
+	// Set the default values, so they are kept for the keys absent in the body
+	if err := b.setDefaults(v); err != nil {
+		return err
 	}
 
-	// Check if the request body is empty
-	if r.Body == nil {
-		return ErrEmptyBody
+	// Decode the request body into the v pointer.
+	// In the strict mode the read body is kept, up to unknownFieldBodyLimit, to find the path of the unknown field.
+	body := &limitedBuffer{limit: unknownFieldBodyLimit}
+	dec := json.NewDecoder(r.Body)
+	if b.strictJSON {
+		dec = json.NewDecoder(io.TeeReader(r.Body, body))
+		dec.DisallowUnknownFields()
+		dec.UseNumber()
+	}
+	if err := dec.Decode(v); err != nil {
+		return errors.Join(ErrDecodeJSON, newJSONBindingErrors(err, v, body.bytes()))
 	}
 
-	// Decode the request body into the v pointer
-	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
-		return errors.Join(ErrDecodeJSON, err)
+	// Reject any data after the JSON document in the strict mode
+	if b.strictJSON {
+		if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+			return fmt.Errorf("%w: unexpected data after the JSON document", ErrDecodeJSON)
+		}
 	}
 
 	return nil
 }
+
+// StrictJSON returns a copy of the binder with the strict JSON decoding mode enabled or disabled,
+// e.g. to decode the body of a single route strictly. See WithStrictJSON for details.
+// The copy shares the decoders with the original binder.
+func (b *Instance) StrictJSON(strict bool) *Instance {
+	c := *b
+	c.strictJSON = strict
+	return &c
+}
+
+// unknownFieldBodyLimit is the maximum size of the JSON body kept in the strict mode
+// to find the path of the unknown field. The unknown fields of the larger bodies are reported by their names only.
+const unknownFieldBodyLimit = 64 << 10
+
+// limitedBuffer keeps the bytes written to it while they fit in the limit, they are dropped once it is exceeded.
+type limitedBuffer struct {
+	buf       []byte
+	limit     int
+	truncated bool
+}
+
+// Write implements the io.Writer interface. It never fails.
+func (h *limitedBuffer) Write(p []byte) (int, error) {
+	if !h.truncated {
+		if len(h.buf)+len(p) > h.limit {
+			h.buf, h.truncated = nil, true
+		} else {
+			h.buf = append(h.buf, p...)
+		}
+	}
+	return len(p), nil
+}
+
+// bytes returns the kept bytes, or nil if the limit is exceeded.
+func (h *limitedBuffer) bytes() []byte {
+	return h.buf
+}
+
+// newJSONBindingErrors converts the JSON type and unknown field errors into binding errors.
+// The body is used to find the path of the unknown field, if it is not empty.
+// It returns the passed error as is, if it is neither of them.
+func newJSONBindingErrors(err error, v interface{}, body []byte) error {
+	if name, ok := unknownJSONField(err); ok {
+		fieldErr := &FieldError{Field: name, Source: SourceJSON, Tag: name, Err: ErrUnknownField}
+		if t := reflect.TypeOf(v).Elem(); t.Kind() == reflect.Struct {
+			fieldErr.Field = fieldPath(t, "json", name)
+			if field, tag, ok := unknownJSONFieldPath(body, t, name); ok {
+				fieldErr.Field, fieldErr.Tag = field, tag
+			}
+		}
+		return BindingErrors{fieldErr}
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		return err
+	}
+
+	fieldErr := &FieldError{
+		Field:  typeErr.Field,
+		Source: SourceJSON,
+		Tag:    typeErr.Field,
+		Value:  typeErr.Value,
+		Err:    fmt.Errorf("%w: %w", ErrInvalidValue, err),
+	}
+	if typeErr.Type != nil {
+		fieldErr.Type = typeErr.Type.String()
+	}
+	if t := reflect.TypeOf(v).Elem(); t.Kind() == reflect.Struct {
+		fieldErr.Field = fieldPath(t, "json", typeErr.Field)
+	}
+
+	return BindingErrors{fieldErr}
+}
+
+// unknownJSONField returns the name of the unknown field from the error of the json.Decoder
+// with DisallowUnknownFields set. The encoding/json package reports unknown fields with a plain error only,
+// formatted as `json: unknown field "name"`, so the name is parsed from the error message.
+func unknownJSONField(err error) (string, bool) {
+	name, ok := strings.CutPrefix(err.Error(), "json: unknown field ")
+	if !ok {
+		return "", false
+	}
+	name, uerr := strconv.Unquote(name)
+	return name, uerr == nil
+}
+
+// unknownJSONFieldPath finds the unknown field with the given name in the JSON body decoded into the struct type t.
+// It returns the struct field path, e.g. "Address.zip", and the JSON key path, e.g. "address.zip",
+// the elements of arrays are indexed, e.g. "Items[1].x" and "items[1].x".
+func unknownJSONFieldPath(body []byte, t reflect.Type, name string) (string, string, bool) {
+	var value interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if len(body) == 0 || dec.Decode(&value) != nil {
+		return "", "", false
+	}
+	return findUnknownJSONField(value, t, name, "", "")
+}
+
+// findUnknownJSONField walks the decoded JSON value along the type t looking for the unknown field with the given name.
+// The field and tag are the paths of the value.
+func findUnknownJSONField(value interface{}, t reflect.Type, name, field, tag string) (string, string, bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return "", "", false
+		}
+
+		for _, key := range sortedKeys(obj) {
+			structField, ok := fieldByAlias(t, "json", key)
+			if !ok {
+				if key == name {
+					return joinPath(field, key), joinPath(tag, key), true
+				}
+				continue
+			}
+			if f, k, ok := findUnknownJSONField(obj[key], structField.Type, name, joinPath(field, structField.Name), joinPath(tag, key)); ok {
+				return f, k, true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		list, ok := value.([]interface{})
+		if !ok {
+			return "", "", false
+		}
+		for i, item := range list {
+			index := "[" + strconv.Itoa(i) + "]"
+			if f, k, ok := findUnknownJSONField(item, t.Elem(), name, field+index, tag+index); ok {
+				return f, k, true
+			}
+		}
+	case reflect.Map:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return "", "", false
+		}
+		for _, key := range sortedKeys(obj) {
+			index := "[" + key + "]"
+			if f, k, ok := findUnknownJSONField(obj[key], t.Elem(), name, field+index, tag+index); ok {
+				return f, k, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// sortedKeys returns the sorted keys of the JSON object, so the same body is always walked in the same order.
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// joinPath appends the name to the dot-separated path.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -1,7 +1,9 @@
 package binder_test
 
 import (
+	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -132,3 +134,150 @@ func TestBindJSON(t *testing.T) {
 		require.Equal(t, CustomInt(123), obj.FieldTwo)
 	})
 }
+
+func TestBindJSONStrict(t *testing.T) {
+	type RequestBody struct {
+		Name  string      `json:"name"`
+		Extra interface{} `json:"extra"`
+	}
+
+	t.Run("lenient by default", func(t *testing.T) {
+		var obj RequestBody
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", []byte(`{"name":"John","unknown":1} garbage`), nil)
+		require.NoError(t, err)
+		err = binder.BindJSON(req, &obj)
+		require.NoError(t, err)
+		require.Equal(t, "John", obj.Name)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		b := binder.New(binder.WithStrictJSON(true))
+
+		var obj RequestBody
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", []byte(`{"name":"John","unknown":1}`), nil)
+		require.NoError(t, err)
+		err = b.BindJSON(req, &obj)
+		require.ErrorIs(t, err, binder.ErrDecodeJSON)
+		require.ErrorIs(t, err, binder.ErrUnknownField)
+
+		var errs binder.BindingErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 1)
+		require.Equal(t, "unknown", errs[0].Tag)
+		require.Equal(t, binder.SourceJSON, errs[0].Source)
+	})
+
+	t.Run("nested unknown field", func(t *testing.T) {
+		type Item struct {
+			SKU string `json:"sku"`
+		}
+		type Order struct {
+			Name    string `json:"name"`
+			Address struct {
+				City string `json:"city"`
+			} `json:"address"`
+			Items []Item `json:"items"`
+		}
+		b := binder.New(binder.WithStrictJSON(true))
+
+		for body, want := range map[string][2]string{
+			`{"name":"John","address":{"city":"Paris","name":"home"}}`: {"Address.name", "address.name"},
+			`{"items":[{"sku":"a"},{"sku":"b","qty":2}]}`:              {"Items[1].qty", "items[1].qty"},
+		} {
+			var obj Order
+			req, err := newBodyRequest(http.MethodPost, "/", "application/json", []byte(body), nil)
+			require.NoError(t, err)
+			err = b.BindJSON(req, &obj)
+			require.ErrorIs(t, err, binder.ErrUnknownField)
+
+			var errs binder.BindingErrors
+			require.ErrorAs(t, err, &errs)
+			require.Len(t, errs, 1)
+			require.Equal(t, want[0], errs[0].Field)
+			require.Equal(t, want[1], errs[0].Tag)
+		}
+	})
+
+	t.Run("unknown field in large body", func(t *testing.T) {
+		type Order struct {
+			Name    string `json:"name"`
+			Address struct {
+				City string `json:"city"`
+			} `json:"address"`
+		}
+		b := binder.New(binder.WithStrictJSON(true))
+
+		// The path is not looked up in the bodies over 64 KB, the field is reported by its name
+		body := `{"name":"` + strings.Repeat("a", 64<<10) + `","address":{"city":"Paris","zip":"75001"}}`
+		var obj Order
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", []byte(body), nil)
+		require.NoError(t, err)
+		err = b.BindJSON(req, &obj)
+		require.ErrorIs(t, err, binder.ErrUnknownField)
+
+		var errs binder.BindingErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 1)
+		require.Equal(t, "zip", errs[0].Tag)
+	})
+
+	t.Run("trailing data", func(t *testing.T) {
+		b := binder.New(binder.WithStrictJSON(true))
+
+		var obj RequestBody
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", []byte(`{"name":"John"} {"name":"Jane"}`), nil)
+		require.NoError(t, err)
+		err = b.BindJSON(req, &obj)
+		require.ErrorIs(t, err, binder.ErrDecodeJSON)
+		require.Equal(t, http.StatusBadRequest, binder.ErrorStatus(err))
+
+		// trailing whitespace is fine
+		req, err = newBodyRequest(http.MethodPost, "/", "application/json", []byte("{\"name\":\"John\"}\n\t "), nil)
+		require.NoError(t, err)
+		err = b.BindJSON(req, &obj)
+		require.NoError(t, err)
+	})
+
+	t.Run("numbers keep precision", func(t *testing.T) {
+		b := binder.New(binder.WithStrictJSON(true))
+
+		var obj RequestBody
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", []byte(`{"extra":9007199254740993}`), nil)
+		require.NoError(t, err)
+		err = b.BindJSON(req, &obj)
+		require.NoError(t, err)
+		require.Equal(t, json.Number("9007199254740993"), obj.Extra)
+	})
+
+	t.Run("per call", func(t *testing.T) {
+		b := binder.New()
+
+		var obj RequestBody
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", []byte(`{"unknown":1}`), nil)
+		require.NoError(t, err)
+		err = b.StrictJSON(true).BindJSON(req, &obj)
+		require.ErrorIs(t, err, binder.ErrUnknownField)
+
+		// the original binder is not affected
+		req, err = newBodyRequest(http.MethodPost, "/", "application/json", []byte(`{"unknown":1}`), nil)
+		require.NoError(t, err)
+		err = b.BindJSON(req, &obj)
+		require.NoError(t, err)
+
+		// and the strict mode can be disabled for a single call
+		req, err = newBodyRequest(http.MethodPost, "/", "application/json", []byte(`{"unknown":1}`), nil)
+		require.NoError(t, err)
+		err = binder.New(binder.WithStrictJSON(true)).StrictJSON(false).BindJSON(req, &obj)
+		require.NoError(t, err)
+	})
+
+	t.Run("bind", func(t *testing.T) {
+		b := binder.New(binder.WithStrictJSON(true))
+
+		var obj RequestBody
+		req, err := newBodyRequest(http.MethodPost, "/", "application/json", []byte(`{"unknown":1}`), nil)
+		require.NoError(t, err)
+		err = b.Bind(req, &obj)
+		require.ErrorIs(t, err, binder.ErrUnknownField)
+	})
+}
@@ -0,0 +1,44 @@
+package binder
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// BindMsgPack binds the passed v pointer to the request using the default binder instance.
+// See Instance.BindMsgPack for details.
+// Implements the binder.BinderFunc interface.
+func BindMsgPack(r *http.Request, v interface{}) error {
+	return defaultInstance.BindMsgPack(r, v)
+}
+
+// BindMsgPack binds the passed v pointer to the request.
+// It uses the application/msgpack content type for binding.
+// `v` param should be a pointer to a struct with `json` tags,
+// so the same struct can be bound from JSON and MessagePack.
+// The bound value is validated afterwards, see WithValidator.
+func (b *Instance) BindMsgPack(r *http.Request, v interface{}) error {
+	if err := b.decodeBody(r, v, b.bindMsgPack); err != nil {
+		return err
+	}
+	return b.validate(v)
+}
+
+// bindMsgPack binds the passed v pointer to the request MessagePack body without validation.
+func (b *Instance) bindMsgPack(r *http.Request, v interface{}) error {
+	// Check the request and the v pointer before decoding the body into it
+	if err := checkBodyRequest(r, v, MIMEApplicationMsgPack, MIMEApplicationXMsgPack, MIMEApplicationVndMsgPack); err != nil {
+		return err
+	}
+
+	// Decode the request body into the v pointer
+	dec := msgpack.NewDecoder(r.Body)
+	dec.SetCustomStructTag("json")
+	if err := dec.Decode(v); err != nil {
+		return errors.Join(ErrDecodeMsgPack, err)
+	}
+
+	return nil
+}
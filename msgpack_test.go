@@ -0,0 +1,64 @@
+package binder_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestBindMsgPack(t *testing.T) {
+	// A test struct with json tags
+	type RequestBody struct {
+		FieldOne string `json:"field_one"`
+		FieldTwo int    `json:"field_two"`
+	}
+
+	// new msgpack request with body and content type
+	newMsgPackRequest := func(method, contentType string, body []byte) *http.Request {
+		req, err := http.NewRequest(method, "/", bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		return req
+	}
+
+	payload, err := msgpack.Marshal(map[string]interface{}{
+		"field_one": "value",
+		"field_two": 123,
+	})
+	require.NoError(t, err)
+
+	t.Run("invalid method", func(t *testing.T) {
+		err := binder.BindMsgPack(newMsgPackRequest(http.MethodGet, "application/msgpack", payload), &RequestBody{})
+		require.ErrorIs(t, err, binder.ErrInvalidMethod)
+	})
+
+	t.Run("invalid content type", func(t *testing.T) {
+		err := binder.BindMsgPack(newMsgPackRequest(http.MethodPost, "application/json", payload), &RequestBody{})
+		require.ErrorIs(t, err, binder.ErrInvalidContentType)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		err := binder.BindMsgPack(newMsgPackRequest(http.MethodPost, "application/msgpack", payload), RequestBody{})
+		require.ErrorIs(t, err, binder.ErrInvalidInput)
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		err := binder.BindMsgPack(newMsgPackRequest(http.MethodPost, "application/msgpack", payload[:5]), &RequestBody{})
+		require.ErrorIs(t, err, binder.ErrDecodeMsgPack)
+	})
+
+	t.Run("successful case", func(t *testing.T) {
+		for _, contentType := range []string{"application/msgpack", "application/x-msgpack", "application/vnd.msgpack"} {
+			obj := &RequestBody{}
+			err := binder.BindFunc(newMsgPackRequest(http.MethodPost, contentType, payload), obj)
+			require.NoError(t, err)
+			require.Equal(t, "value", obj.FieldOne)
+			require.Equal(t, 123, obj.FieldTwo)
+		}
+	})
+}
@@ -1,6 +1,9 @@
 package binder
 
 import (
+	"bufio"
+	"context"
+	"encoding"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +13,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // File represents a file that was uploaded via a multipart form.
@@ -20,15 +24,48 @@ type File struct {
 	FileSize int64
 	// ContentType stores the MIME type of the file
 	ContentType string
-	// Data is a byte slice that holds the contents of the file
+	// Data is a byte slice that holds the contents of the file.
+	// It is empty if the file is saved to the file store, see WithFileStore.
 	Data []byte
+	// Key stores the storage key of the file saved to the file store, see WithFileStore
+	Key string
+}
+
+// BindFormMultipart binds the passed v pointer to the request using the default binder instance.
+// See Instance.BindFormMultipart for details.
+// Implements the binder.BinderFunc interface.
+func BindFormMultipart(r *http.Request, v interface{}) error {
+	return defaultInstance.BindFormMultipart(r, v)
 }
 
 // BindFormMultipart binds the passed v pointer to the request.
 // It uses the multipart/form-data content type for binding.
 // `v` param should be a pointer to a struct with `form“ tags.
-// Implements the binder.BinderFunc interface.
-func BindFormMultipart(r *http.Request, v interface{}) error {
+// Repeated form values are bound to slice and array fields, the values are also split by commas
+// if the field tag has the split option, e.g. `form:"tags,split"`.
+// The time values are parsed the same way as in BindQuery.
+// Multiple files uploaded with the same field name are bound to []File or []*File fields.
+// If the file store is set, the uploaded files are saved to the store, see WithFileStore.
+// Upload fields are streamed by BindFormMultipartStream only, they are rejected with ErrInvalidInput.
+// File fields may limit the upload size and MIME types with the tag options,
+// e.g. `form:"avatar,maxsize=5MB,mime=image/png|image/jpeg"`. The MIME type is detected
+// from the file contents, and the violations are reported as field errors.
+// The files stored during the call are deleted if binding or validation fails.
+// The bound value is validated afterwards, see WithValidator.
+func (b *Instance) BindFormMultipart(r *http.Request, v interface{}) error {
+	b = b.trackStoredFiles()
+	if err := b.decodeBody(r, v, b.bindFormMultipart); err != nil {
+		return err
+	}
+	if err := b.validate(v); err != nil {
+		b.deleteStoredFiles(r.Context())
+		return err
+	}
+	return nil
+}
+
+// bindFormMultipart binds the passed v pointer to the request multipart form without validation.
+func (b *Instance) bindFormMultipart(r *http.Request, v interface{}) error {
 	// Check if the request method is POST, PUT or PATCH
 	if !isPostPutPatch(r) {
 		return fmt.Errorf("%w: %s", ErrInvalidMethod, r.Method)
@@ -40,7 +77,7 @@ func BindFormMultipart(r *http.Request, v interface{}) error {
 	}
 
 	// Parse the request body
-	if err := r.ParseMultipartForm(MultiPartFormMaxMemory); err != nil {
+	if err := r.ParseMultipartForm(b.maxMemory()); err != nil {
 		return errors.Join(ErrParseForm, err)
 	}
 
@@ -64,140 +101,399 @@ func BindFormMultipart(r *http.Request, v interface{}) error {
 	// Get the target type
 	targetType := targetElem.Type()
 
-	// Iterate over the target fields
+	// The Upload fields are streamed by BindFormMultipartStream only,
+	// reject them before any file is read or stored
+	for i := 0; i < targetType.NumField(); i++ {
+		field := targetType.Field(i)
+		if isUploadType(field.Type) {
+			return fmt.Errorf("%w: the %s field of the %s type is bound with BindFormMultipartStream only",
+				ErrInvalidInput, field.Name, field.Type)
+		}
+	}
+
+	// Iterate over the target fields and collect the field errors
+	var errs BindingErrors
 	for i := 0; i < targetType.NumField(); i++ {
 		field := targetType.Field(i)
-		tagStr := field.Tag.Get(TagForm)
-		tag := strings.Split(tagStr, ",")[0]
+		tag, optionsStr, _ := strings.Cut(field.Tag.Get(b.formTag), ",")
+		options := strings.Split(optionsStr, ",")
 
 		// Skip if tag is empty or "-"
 		if tag == "" || tag == "-" {
 			continue
 		}
 
-		// Bind form values
-		if formValue := r.FormValue(tag); formValue != "" {
-			fieldValue := targetElem.Field(i)
-			if fieldValue.CanSet() {
-				switch fieldValue.Kind() {
-				case reflect.String:
-					fieldValue.SetString(formValue)
-				case reflect.Complex128, reflect.Complex64:
-					complexValue, err := strconv.ParseComplex(formValue, 64)
-					if err != nil {
-						return err
-					}
-					fieldValue.SetComplex(complexValue)
-				// FIXME: fix mapping of arrays and slices to a struct
-				// case reflect.Array:
-				// 	arrSlice := strings.Split(formValue, ",")
-				// 	arr := reflect.New(fieldValue.Type()).Elem()
-				// 	fmt.Println(arrSlice)
-				// 	for i := 0; i < arr.Len(); i++ {
-				// 		arr.Index(i).Set(reflect.ValueOf(arrSlice[i]))
-				// 	}
-				// 	fieldValue.Set(reflect.ValueOf(arr))
-				// case reflect.Slice:
-				// 	fieldValue.Set(reflect.ValueOf(strings.Split(formValue, ",")))
-				case reflect.Map:
-					var mapValue map[string]interface{}
-					if err := json.Unmarshal([]byte(formValue), &mapValue); err != nil {
-						return err
-					}
-					fieldValue.Set(reflect.ValueOf(mapValue))
-				case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-					intValue, err := strconv.ParseInt(formValue, 10, 64)
-					if err != nil {
-						return err
-					}
-					fieldValue.SetInt(intValue)
-				case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-					uintValue, err := strconv.ParseUint(formValue, 10, 64)
-					if err != nil {
-						return err
-					}
-					fieldValue.SetUint(uintValue)
-				case reflect.Float32, reflect.Float64:
-					floatValue, err := strconv.ParseFloat(formValue, 64)
-					if err != nil {
-						return err
-					}
-					fieldValue.SetFloat(floatValue)
-				case reflect.Bool:
-					boolValue, err := strconv.ParseBool(formValue)
-					if err != nil {
-						return err
-					}
-					fieldValue.SetBool(boolValue)
-				case reflect.Ptr, reflect.Struct:
-					err := json.Unmarshal([]byte(formValue), fieldValue.Addr().Interface())
-					if err != nil {
-						return err
-					}
-				default:
-					return fmt.Errorf("%w: %s", ErrUnsupportedType, fieldValue.Kind())
-				}
+		// Report the required field without values and files as missing
+		if hasOption(options, OptionRequired) {
+			formValues := r.Form[tag]
+			if def, ok := field.Tag.Lookup(TagDefault); ok && b.isAbsent(formValues) {
+				formValues = defaultValues(field.Type, def)
+			}
+			if isMissing(formValues) && len(r.MultipartForm.File[tag]) == 0 {
+				errs = append(errs, &FieldError{
+					Field:  field.Name,
+					Source: SourceForm,
+					Tag:    tag,
+					Type:   field.Type.String(),
+					Err:    ErrMissingField,
+				})
+				continue
 			}
 		}
 
-		// skip if the field is not a binder.File or *binder.File
-		if field.Type.Kind() != reflect.ValueOf(File{}).Kind() &&
-			field.Type.Kind() != reflect.ValueOf(&File{}).Kind() {
+		// Bind repeated or comma-separated form values to the slice or array field
+		if isFormValueList(field.Type) {
+			formValues := r.Form[tag]
+			if def, ok := field.Tag.Lookup(TagDefault); ok && b.isAbsent(formValues) {
+				formValues = defaultValues(field.Type, def)
+			}
+			if hasOption(options, OptionSplit) {
+				formValues = splitFormValues(formValues)
+			}
+			fieldValue := targetElem.Field(i)
+			if len(formValues) > 0 && fieldValue.CanSet() {
+				errs = append(errs, b.setFormValues(fieldValue, field, tag, formValues)...)
+			}
 			continue
 		}
 
-		// Bind file data
-		if fileStruct, err := bindFormFile(r, tag); fileStruct != nil && err == nil {
-			// Marshal the File struct to JSON
-			jsonBytes, err := json.Marshal(fileStruct)
+		// Bind form values, using the default value if the value is absent
+		formValue := r.FormValue(tag)
+		if def, ok := field.Tag.Lookup(TagDefault); ok && b.isAbsent(r.Form[tag]) {
+			formValue = def
+		}
+		if formValue != "" {
+			fieldValue := targetElem.Field(i)
+			if fieldValue.CanSet() {
+				if err := b.setFormValue(fieldValue, formValue, options); err != nil {
+					errs = append(errs, &FieldError{
+						Field:  field.Name,
+						Source: SourceForm,
+						Tag:    tag,
+						Value:  formValue,
+						Type:   field.Type.String(),
+						Err:    err,
+					})
+				}
+			}
+		}
+
+		// Bind multiple files to the []binder.File or []*binder.File field
+		if isFileSlice(field.Type) {
+			constraints, err := parseFileConstraints(options)
 			if err != nil {
+				b.deleteStoredFiles(r.Context())
 				return err
 			}
-
-			// Unmarshal the JSON to the target field
 			fieldValue := targetElem.Field(i)
-			if fieldValue.CanSet() {
-				switch fieldValue.Kind() {
-				case reflect.Ptr, reflect.Struct:
-					err = json.Unmarshal(jsonBytes, fieldValue.Addr().Interface())
-					if err != nil {
-						return err
-					}
-				default:
-					// skip if the field is not a binder.File or *binder.File
+			if !fieldValue.CanSet() {
+				continue
+			}
+			files := reflect.MakeSlice(field.Type, 0, len(r.MultipartForm.File[tag]))
+			for j, header := range r.MultipartForm.File[tag] {
+				fileStruct, err := b.openFormFile(r.Context(), header, constraints)
+				if errors.Is(err, ErrFileTooLarge) || errors.Is(err, ErrInvalidFileType) {
+					// Report the violated upload constraint and skip the file
+					errs = append(errs, &FieldError{
+						Field:  fmt.Sprintf("%s[%d]", field.Name, j),
+						Source: SourceForm,
+						Tag:    tag,
+						Value:  header.Filename,
+						Type:   field.Type.Elem().String(),
+						Err:    err,
+					})
+					continue
+				}
+				if err != nil {
+					fieldValue.Set(files)
+					b.deleteStoredFiles(r.Context())
+					return err
+				}
+				if field.Type.Elem().Kind() == reflect.Ptr {
+					files = reflect.Append(files, reflect.ValueOf(fileStruct))
+				} else {
+					files = reflect.Append(files, reflect.ValueOf(*fileStruct))
 				}
 			}
+			if files.Len() > 0 {
+				fieldValue.Set(files)
+			}
+			continue
+		}
+
+		// skip if the field is not a binder.File or *binder.File
+		if !isFileType(field.Type) {
+			continue
+		}
+
+		// Bind file data
+		constraints, err := parseFileConstraints(options)
+		if err != nil {
+			b.deleteStoredFiles(r.Context())
+			return err
+		}
+		fileStruct, err := b.bindFormFile(r, tag, constraints)
+		if errors.Is(err, ErrFileTooLarge) || errors.Is(err, ErrInvalidFileType) {
+			// Report the violated upload constraint and skip the file
+			errs = append(errs, &FieldError{
+				Field:  field.Name,
+				Source: SourceForm,
+				Tag:    tag,
+				Value:  r.MultipartForm.File[tag][0].Filename,
+				Type:   field.Type.String(),
+				Err:    err,
+			})
+			continue
+		}
+		if err != nil {
+			b.deleteStoredFiles(r.Context())
+			return err
+		}
+		fieldValue := targetElem.Field(i)
+		if fileStruct != nil && fieldValue.CanSet() {
+			if field.Type.Kind() == reflect.Ptr {
+				fieldValue.Set(reflect.ValueOf(fileStruct))
+			} else {
+				fieldValue.Set(reflect.ValueOf(*fileStruct))
+			}
 		}
 	}
 
+	if len(errs) > 0 {
+		b.deleteStoredFiles(r.Context())
+		return errors.Join(ErrDecodeForm, errs)
+	}
+
 	return nil
 }
 
-func bindFormFile(r *http.Request, tag string) (*File, error) {
-	if formFile, header, err := r.FormFile(tag); err == nil {
-		defer func(formFile multipart.File) {
-			_ = formFile.Close()
-		}(formFile)
-		fileData, err := io.ReadAll(formFile)
+// setFormValue converts the form value to the field type and sets it to the field.
+// The registered converters, FormValueUnmarshaler and encoding.TextUnmarshaler take precedence
+// over the conversion by the field kind. The tag options are used to parse the time values, see OptionLayout.
+func (b *Instance) setFormValue(fieldValue reflect.Value, formValue string, options []string) error {
+	if ok, err := b.convert(fieldValue, formValue); ok {
+		return err
+	}
+
+	// Convert the pointed value, so the pointer element type is checked for the unmarshalers as well
+	if fieldValue.Kind() == reflect.Ptr {
+		elem := reflect.New(fieldValue.Type().Elem())
+		if err := b.setFormValue(elem.Elem(), formValue, options); err != nil {
+			return err
+		}
+		fieldValue.Set(elem)
+		return nil
+	}
+
+	if u, ok := unmarshalerOf(fieldValue, formValueUnmarshalerType).(FormValueUnmarshaler); ok {
+		if err := u.UnmarshalFormValue(formValue); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+		}
+		return nil
+	}
+
+	switch fieldValue.Type() {
+	case timeType:
+		timeValue, err := b.parseTime(formValue, options)
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("%w: %w", ErrInvalidValue, err)
 		}
+		fieldValue.Set(reflect.ValueOf(timeValue))
+		return nil
+	case durationType:
+		durationValue, err := time.ParseDuration(formValue)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+		}
+		fieldValue.SetInt(int64(durationValue))
+		return nil
+	}
 
-		// Get the file mime type
-		mime, err := GetFileMimeType(fileData)
+	if u, ok := unmarshalerOf(fieldValue, textUnmarshalerType).(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText([]byte(formValue)); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+		}
+		return nil
+	}
+
+	switch fieldValue.Kind() {
+	case reflect.String:
+		fieldValue.SetString(formValue)
+	case reflect.Complex128, reflect.Complex64:
+		complexValue, err := strconv.ParseComplex(formValue, fieldValue.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+		}
+		fieldValue.SetComplex(complexValue)
+	case reflect.Map:
+		var mapValue map[string]interface{}
+		if err := json.Unmarshal([]byte(formValue), &mapValue); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+		}
+		fieldValue.Set(reflect.ValueOf(mapValue))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		intValue, err := strconv.ParseInt(formValue, 10, fieldValue.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+		}
+		fieldValue.SetInt(intValue)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		uintValue, err := strconv.ParseUint(formValue, 10, fieldValue.Type().Bits())
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("%w: %w", ErrInvalidValue, err)
 		}
+		fieldValue.SetUint(uintValue)
+	case reflect.Float32, reflect.Float64:
+		floatValue, err := strconv.ParseFloat(formValue, fieldValue.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+		}
+		fieldValue.SetFloat(floatValue)
+	case reflect.Bool:
+		boolValue, err := strconv.ParseBool(formValue)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+		}
+		fieldValue.SetBool(boolValue)
+	case reflect.Ptr, reflect.Struct:
+		err := json.Unmarshal([]byte(formValue), fieldValue.Addr().Interface())
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedType, fieldValue.Kind())
+	}
+	return nil
+}
+
+// setFormValues converts the form values to the elements of the slice or array field and sets them to the field.
+// Empty values are bound as zero elements. It returns the field errors of the invalid elements.
+func (b *Instance) setFormValues(fieldValue reflect.Value, field reflect.StructField, tag string, formValues []string) BindingErrors {
+	var errs BindingErrors
+	options := strings.Split(field.Tag.Get(b.formTag), ",")[1:]
+	list := reflect.New(fieldValue.Type()).Elem()
+	if list.Kind() == reflect.Slice {
+		list.Set(reflect.MakeSlice(list.Type(), len(formValues), len(formValues)))
+	} else if len(formValues) > list.Len() {
+		errs = append(errs, &FieldError{
+			Field:  field.Name,
+			Source: SourceForm,
+			Tag:    tag,
+			Value:  strings.Join(formValues, ","),
+			Type:   field.Type.String(),
+			Err:    fmt.Errorf("%w: at most %d values are allowed", ErrInvalidValue, list.Len()),
+		})
+		return errs
+	}
 
-		// Create a new File struct
-		fileStruct := &File{
-			FileName:    header.Filename,
-			FileSize:    header.Size,
-			ContentType: mime,
-			Data:        fileData,
+	for i, formValue := range formValues {
+		if formValue == "" {
+			continue
 		}
+		if err := b.setFormValue(list.Index(i), formValue, options); err != nil {
+			errs = append(errs, &FieldError{
+				Field:  fmt.Sprintf("%s[%d]", field.Name, i),
+				Source: SourceForm,
+				Tag:    tag,
+				Value:  formValue,
+				Type:   field.Type.Elem().String(),
+				Err:    err,
+			})
+		}
+	}
+	if len(errs) == 0 {
+		fieldValue.Set(list)
+	}
+	return errs
+}
+
+// isFormValueList reports whether the form values are bound to the type as a list,
+// i.e. the type is a slice or array of anything but files.
+func isFormValueList(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && !isFileSlice(t)
+}
+
+// splitFormValues splits the comma-separated form values, trimming the spaces around the elements.
+func splitFormValues(formValues []string) []string {
+	var values []string
+	for _, formValue := range formValues {
+		for _, value := range strings.Split(formValue, ",") {
+			values = append(values, strings.TrimSpace(value))
+		}
+	}
+	return values
+}
 
+// bindFormFile returns the first file uploaded with the form field, or nil if there is no such file.
+func (b *Instance) bindFormFile(r *http.Request, tag string, constraints fileConstraints) (*File, error) {
+	if r.MultipartForm == nil || len(r.MultipartForm.File[tag]) == 0 {
+		return nil, nil
+	}
+	return b.openFormFile(r.Context(), r.MultipartForm.File[tag][0], constraints)
+}
+
+// openFormFile reads the uploaded file into the File struct.
+// The file is checked against the upload constraints before it is read.
+// If the file store is set, the file is saved to the store instead of being read into memory.
+func (b *Instance) openFormFile(ctx context.Context, header *multipart.FileHeader, constraints fileConstraints) (*File, error) {
+	// Check the file size before reading it
+	if err := constraints.checkSize(header.Size); err != nil {
+		return nil, err
+	}
+
+	formFile, err := header.Open()
+	if err != nil {
+		return nil, errors.Join(ErrGetFile, err)
+	}
+	defer func(formFile multipart.File) {
+		_ = formFile.Close()
+	}(formFile)
+
+	// Get the file mime type from the first few KB of the file
+	reader := bufio.NewReaderSize(formFile, mimeSniffLen)
+	mime, err := sniffMimeType(reader)
+	if err != nil {
+		return nil, err
+	}
+	if err := constraints.checkMimeType(mime); err != nil {
+		return nil, err
+	}
+
+	// Create a new File struct
+	fileStruct := &File{
+		FileName:    header.Filename,
+		FileSize:    header.Size,
+		ContentType: mime,
+	}
+
+	// Copy the file into the file store
+	if b.fileStore != nil {
+		key, err := b.saveFile(ctx, header.Filename, mime, reader)
+		if err != nil {
+			return nil, errors.Join(ErrSaveFile, err)
+		}
+		fileStruct.Key = key
 		return fileStruct, nil
 	}
-	return nil, nil
+
+	fileData, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Join(ErrReadFile, err)
+	}
+	fileStruct.Data = fileData
+
+	return fileStruct, nil
+}
+
+// fileType is the reflect.Type of File.
+var fileType = reflect.TypeOf(File{})
+
+// isFileType reports whether the type is binder.File or *binder.File.
+func isFileType(t reflect.Type) bool {
+	return t == fileType || t == reflect.PointerTo(fileType)
+}
+
+// isFileSlice reports whether the type is []binder.File or []*binder.File.
+func isFileSlice(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && isFileType(t.Elem())
 }
@@ -107,3 +107,173 @@ func TestBindFormMultipart(t *testing.T) {
 		})
 	}
 }
+
+func TestBindFormMultipartFiles(t *testing.T) {
+	testImage, err := os.ReadFile("testdata/test.jpg")
+	require.NoError(t, err)
+
+	// the files uploaded under the same field name
+	photoA := multipartFile{"photos", "a.jpg", testImage}
+	photoB := multipartFile{"photos", "b.txt", []byte("plain text")}
+
+	t.Run("slice of pointers", func(t *testing.T) {
+		var form struct {
+			Photos []*binder.File `form:"photos"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", nil, []multipartFile{photoA, photoB}, nil)
+		require.NoError(t, err)
+		err = binder.BindFormMultipart(req, &form)
+		require.NoError(t, err)
+		require.Len(t, form.Photos, 2)
+		require.Equal(t, "a.jpg", form.Photos[0].FileName)
+		require.Equal(t, "image/jpeg", form.Photos[0].ContentType)
+		require.Equal(t, testImage, form.Photos[0].Data)
+		require.Equal(t, "b.txt", form.Photos[1].FileName)
+		require.Equal(t, "text/plain", form.Photos[1].ContentType)
+		require.Equal(t, int64(10), form.Photos[1].FileSize)
+	})
+
+	t.Run("slice of values", func(t *testing.T) {
+		var form struct {
+			Photos []binder.File `form:"photos"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", nil, []multipartFile{photoB, photoA}, nil)
+		require.NoError(t, err)
+		err = binder.BindFormMultipart(req, &form)
+		require.NoError(t, err)
+		require.Len(t, form.Photos, 2)
+		require.Equal(t, "b.txt", form.Photos[0].FileName)
+		require.Equal(t, "a.jpg", form.Photos[1].FileName)
+	})
+
+	t.Run("no files", func(t *testing.T) {
+		var form struct {
+			Photos []*binder.File `form:"photos"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", nil, nil, nil)
+		require.NoError(t, err)
+		err = binder.BindFormMultipart(req, &form)
+		require.NoError(t, err)
+		require.Nil(t, form.Photos)
+	})
+
+	t.Run("constraints per file", func(t *testing.T) {
+		store := binder.NewMemoryFileStore()
+		b := binder.New(binder.WithFileStore(store))
+
+		var form struct {
+			Photos []*binder.File `form:"photos,maxsize=1MB,mime=image/*"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", nil, []multipartFile{photoA, photoB}, nil)
+		require.NoError(t, err)
+		err = b.BindFormMultipart(req, &form)
+		require.ErrorIs(t, err, binder.ErrInvalidFileType)
+
+		var errs binder.BindingErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 1)
+		require.Equal(t, "Photos[1]", errs[0].Field)
+		require.Equal(t, "photos", errs[0].Tag)
+		require.Equal(t, "b.txt", errs[0].Value)
+
+		// the valid file is removed from the store as binding failed
+		require.Zero(t, store.Len())
+	})
+}
+
+func TestBindFormMultipartLists(t *testing.T) {
+	t.Run("repeated keys", func(t *testing.T) {
+		var form struct {
+			Tags    []string  `form:"tags"`
+			IDs     []int64   `form:"ids"`
+			Ratios  []float32 `form:"ratios"`
+			Flags   []bool    `form:"flags"`
+			Sizes   []uint8   `form:"sizes"`
+			Missing []int     `form:"missing"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", [][2]string{
+			{"tags", "a"}, {"tags", "b,c"},
+			{"ids", "1"}, {"ids", "2"},
+			{"ratios", "0.5"}, {"ratios", "1.5"},
+			{"flags", "true"}, {"flags", "false"},
+			{"sizes", "8"}, {"sizes", "16"},
+		}, nil, nil)
+		require.NoError(t, err)
+		err = binder.BindFormMultipart(req, &form)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b,c"}, form.Tags)
+		require.Equal(t, []int64{1, 2}, form.IDs)
+		require.Equal(t, []float32{0.5, 1.5}, form.Ratios)
+		require.Equal(t, []bool{true, false}, form.Flags)
+		require.Equal(t, []uint8{8, 16}, form.Sizes)
+		require.Nil(t, form.Missing)
+	})
+
+	t.Run("comma-separated values", func(t *testing.T) {
+		var form struct {
+			Tags []string `form:"tags,split"`
+			IDs  []int    `form:"ids,split"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", [][2]string{
+			{"tags", "a, b"}, {"tags", "c"},
+			{"ids", "1,2,3"},
+		}, nil, nil)
+		require.NoError(t, err)
+		err = binder.BindFormMultipart(req, &form)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b", "c"}, form.Tags)
+		require.Equal(t, []int{1, 2, 3}, form.IDs)
+	})
+
+	t.Run("fixed-size arrays", func(t *testing.T) {
+		var form struct {
+			Point [3]int    `form:"point,split"`
+			Names [2]string `form:"names"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", [][2]string{
+			{"point", "1,2,3"},
+			{"names", "first"},
+		}, nil, nil)
+		require.NoError(t, err)
+		err = binder.BindFormMultipart(req, &form)
+		require.NoError(t, err)
+		require.Equal(t, [3]int{1, 2, 3}, form.Point)
+		require.Equal(t, [2]string{"first", ""}, form.Names)
+	})
+
+	t.Run("too many values for array", func(t *testing.T) {
+		var form struct {
+			Point [2]int `form:"point,split"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", [][2]string{{"point", "1,2,3"}}, nil, nil)
+		require.NoError(t, err)
+		err = binder.BindFormMultipart(req, &form)
+		require.ErrorIs(t, err, binder.ErrInvalidValue)
+		require.Equal(t, [2]int{}, form.Point)
+	})
+
+	t.Run("invalid elements", func(t *testing.T) {
+		var form struct {
+			IDs   []int  `form:"ids"`
+			Sizes []int8 `form:"sizes"`
+		}
+		req, err := newMultipartRequest(http.MethodPost, "/upload", [][2]string{
+			{"ids", "1"}, {"ids", "two"},
+			{"sizes", "1000"},
+		}, nil, nil)
+		require.NoError(t, err)
+		err = binder.BindFormMultipart(req, &form)
+		require.ErrorIs(t, err, binder.ErrDecodeForm)
+		require.ErrorIs(t, err, binder.ErrInvalidValue)
+		require.Nil(t, form.IDs)
+
+		var errs binder.BindingErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 2)
+		require.Equal(t, "IDs[1]", errs[0].Field)
+		require.Equal(t, "ids", errs[0].Tag)
+		require.Equal(t, "two", errs[0].Value)
+		require.Equal(t, "int", errs[0].Type)
+		require.Equal(t, "Sizes[0]", errs[1].Field)
+	})
+}
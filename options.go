@@ -0,0 +1,185 @@
+package binder
+
+import "time"
+
+// Option is a function that configures a binder instance.
+type Option func(*Instance)
+
+// WithFormTag sets the struct tag name used for form and multipart binding.
+// Default value is TagForm.
+func WithFormTag(tag string) Option {
+	return func(b *Instance) {
+		b.formTag = tag
+	}
+}
+
+// WithQueryTag sets the struct tag name used for query binding.
+// Default value is TagQuery.
+func WithQueryTag(tag string) Option {
+	return func(b *Instance) {
+		b.queryTag = tag
+	}
+}
+
+// WithPathTag sets the struct tag name used for path parameter binding.
+// Default value is TagPath.
+func WithPathTag(tag string) Option {
+	return func(b *Instance) {
+		b.pathTag = tag
+	}
+}
+
+// WithPathParamExtractor sets the extractor used to read path parameters from the request.
+// Use ChiExtractor or GorillaMuxExtractor when the routes are served by those routers.
+// Default value is ServeMuxExtractor.
+func WithPathParamExtractor(e PathParamExtractor) Option {
+	return func(b *Instance) {
+		b.pathExtractor = e
+	}
+}
+
+// WithHeaderTag sets the struct tag name used for header binding.
+// Default value is TagHeader.
+func WithHeaderTag(tag string) Option {
+	return func(b *Instance) {
+		b.headerTag = tag
+	}
+}
+
+// WithCookieTag sets the struct tag name used for cookie binding.
+// Default value is TagCookie.
+func WithCookieTag(tag string) Option {
+	return func(b *Instance) {
+		b.cookieTag = tag
+	}
+}
+
+// WithCookieSigningKey sets the HMAC key used to verify cookies marked as signed,
+// e.g. `cookie:"prefs,signed"`. Use SignCookie with the same key to sign the values.
+func WithCookieSigningKey(key []byte) Option {
+	return func(b *Instance) {
+		b.cookieKey = key
+	}
+}
+
+// WithDecoder registers the body decoder for the media type, see Instance.RegisterDecoder.
+func WithDecoder(mediaType string, d BodyDecoder) Option {
+	return func(b *Instance) {
+		b.RegisterDecoder(mediaType, d)
+	}
+}
+
+// WithFileStore sets the storage sink for the files uploaded via multipart forms.
+// The bound File has the storage key set instead of the Data. Note that the files are copied into the store
+// from the parsed multipart form, which buffers them in memory up to the WithMultipartMaxMemory limit
+// and in temporary files beyond it, use BindFormMultipartStream to stream the files without buffering.
+func WithFileStore(s FileStore) Option {
+	return func(b *Instance) {
+		b.fileStore = s
+	}
+}
+
+// WithValidator sets the validator run right after the request has been bound.
+// Validation failures are returned wrapped with ErrValidation,
+// use NewPlaygroundValidator to adapt the github.com/go-playground/validator package.
+// Targets implementing SelfValidator are validated by their own Validate method afterwards,
+// use a ValidatorFunc returning nil to run only the targets' own validation.
+func WithValidator(v Validator) Option {
+	return func(b *Instance) {
+		b.validator = v
+	}
+}
+
+// WithIgnoreUnknownKeys controls the behavior when the query or form
+// contains keys that do not map to any struct field.
+// If ignore is false, binding fails on unknown keys.
+// Default value is true.
+func WithIgnoreUnknownKeys(ignore bool) Option {
+	return func(b *Instance) {
+		b.ignoreUnknownKeys = ignore
+	}
+}
+
+// WithZeroEmpty controls the behavior when the query or form contains empty values.
+// If zero is true, empty values set the zero value of the field,
+// otherwise the field keeps its current value.
+// Default value is true.
+func WithZeroEmpty(zero bool) Option {
+	return func(b *Instance) {
+		b.zeroEmpty = zero
+	}
+}
+
+// WithMultipartMaxMemory sets the maximum amount of memory to use when parsing a multipart form.
+// It is passed to http.Request.ParseMultipartForm.
+// Default value is MultiPartFormMaxMemory.
+func WithMultipartMaxMemory(maxMemory int64) Option {
+	return func(b *Instance) {
+		b.multipartMaxMemory = maxMemory
+	}
+}
+
+// WithTimeLocation sets the time zone of the time values bound without an explicit zone,
+// e.g. the values parsed with the layout option `query:"day,layout=2006-01-02"`.
+// Unix time values are converted to this time zone as well.
+// Default value is time.UTC.
+func WithTimeLocation(loc *time.Location) Option {
+	return func(b *Instance) {
+		if loc != nil {
+			b.location = loc
+		}
+	}
+}
+
+// WithDefaultOnEmpty controls when the default values of the `default` struct tag are used.
+// If empty is true, the default value is used if the value is absent or empty,
+// otherwise it is used only if the value is absent.
+// Default value is false.
+func WithDefaultOnEmpty(empty bool) Option {
+	return func(b *Instance) {
+		b.defaultOnEmpty = empty
+	}
+}
+
+// WithStrictJSON enables or disables the strict JSON decoding mode.
+// In the strict mode, the JSON body with unknown fields or with any data after the JSON document is rejected,
+// and numbers bound to interface{} values are decoded as json.Number to keep their precision.
+// Use Instance.StrictJSON to change the mode for a single call.
+// Default value is false.
+func WithStrictJSON(strict bool) Option {
+	return func(b *Instance) {
+		b.strictJSON = strict
+	}
+}
+
+// WithMaxBodySize sets the maximum size of the request body in bytes.
+// The bodies exceeding the limit are rejected with ErrBodyTooLarge.
+// A negative value disables the limit, zero falls back to the package-level MaxBodySize.
+// Use Instance.MaxBodySize to change the limit for a single call.
+// Default value is zero.
+func WithMaxBodySize(n int64) Option {
+	return func(b *Instance) {
+		b.maxBodySize = n
+	}
+}
+
+// WithMaxDecompressedSize sets the maximum size of the request body in bytes after decompression,
+// so a small compressed body cannot expand into gigabytes.
+// The bodies exceeding the limit are rejected with ErrBodyTooLarge.
+// A negative value disables the limit, zero falls back to the package-level MaxDecompressedSize.
+// Default value is zero.
+func WithMaxDecompressedSize(n int64) Option {
+	return func(b *Instance) {
+		b.maxDecompressedSize = n
+	}
+}
+
+// WithMaxDecompressionRatio sets the maximum ratio of the decompressed request body size to the compressed one.
+// The bodies exceeding the ratio are rejected with ErrBodyTooLarge.
+// A negative value disables the limit, zero falls back to the package-level MaxDecompressionRatio.
+// Default value is zero.
+func WithMaxDecompressionRatio(ratio int64) Option {
+	return func(b *Instance) {
+		b.maxDecompressionRatio = ratio
+	}
+}
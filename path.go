@@ -0,0 +1,97 @@
+package binder
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/mux"
+)
+
+// PathParamExtractor is the interface that wraps the PathParam method.
+//
+// PathParam returns the value of the named path parameter of the request.
+// It returns false if the request has no such parameter.
+// Implementations adapt the binder to the router in use.
+type PathParamExtractor interface {
+	PathParam(r *http.Request, name string) (string, bool)
+}
+
+// ServeMuxExtractor extracts path parameters matched by the standard library http.ServeMux.
+// It uses http.Request.PathValue under the hood.
+type ServeMuxExtractor struct{}
+
+// PathParam implements the PathParamExtractor interface.
+func (ServeMuxExtractor) PathParam(r *http.Request, name string) (string, bool) {
+	value := r.PathValue(name)
+	return value, value != ""
+}
+
+// ChiExtractor extracts path parameters from the github.com/go-chi/chi route context.
+type ChiExtractor struct{}
+
+// PathParam implements the PathParamExtractor interface.
+func (ChiExtractor) PathParam(r *http.Request, name string) (string, bool) {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil {
+		return "", false
+	}
+	for i, key := range rctx.URLParams.Keys {
+		if key == name {
+			return rctx.URLParams.Values[i], true
+		}
+	}
+	return "", false
+}
+
+// GorillaMuxExtractor extracts path parameters from the github.com/gorilla/mux route variables.
+type GorillaMuxExtractor struct{}
+
+// PathParam implements the PathParamExtractor interface.
+func (GorillaMuxExtractor) PathParam(r *http.Request, name string) (string, bool) {
+	value, ok := mux.Vars(r)[name]
+	return value, ok
+}
+
+// BindPath binds the passed v pointer to the request path parameters using the default binder instance.
+// See Instance.BindPath for details.
+// Implements the binder.BinderFunc interface.
+func BindPath(r *http.Request, v interface{}) error {
+	return defaultInstance.BindPath(r, v)
+}
+
+// BindPath binds the passed v pointer to the request path parameters.
+// Parameters are extracted with the configured PathParamExtractor,
+// by default ServeMuxExtractor is used.
+// `v` param should be a pointer to a struct with `path` tags.
+// Values are converted the same way as in BindQuery.
+// The bound value is validated afterwards, see WithValidator.
+func (b *Instance) BindPath(r *http.Request, v interface{}) error {
+	if err := b.bindPath(r, v); err != nil {
+		return err
+	}
+	return b.validate(v)
+}
+
+// bindPath binds the passed v pointer to the request path parameters without validation.
+func (b *Instance) bindPath(r *http.Request, v interface{}) error {
+	// Validate v pointer before decoding path parameters into it
+	if !isPointer(v) {
+		return errors.Join(ErrInvalidInput, ErrTargetMustBeAPointer)
+	}
+
+	// Collect the path parameters referenced by the struct tags
+	params := make(map[string][]string)
+	for _, field := range taggedFields(v, b.pathTag) {
+		if value, ok := b.pathExtractor.PathParam(r, field.name); ok {
+			params[field.name] = []string{value}
+		}
+	}
+
+	// Decode the path parameters into the v pointer and handle decoding errors
+	if err := b.decodeValues(b.pathDecoder, v, b.pathTag, SourcePath, params); err != nil {
+		return errors.Join(ErrDecodePath, err)
+	}
+
+	return nil
+}
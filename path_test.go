@@ -0,0 +1,81 @@
+package binder_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestBindPath(t *testing.T) {
+	type Params struct {
+		ID   int    `path:"id"`
+		Slug string `path:"slug"`
+	}
+
+	// serve the request with the given router and bind path parameters in the handler
+	serve := func(router http.Handler, b *binder.Instance, target string) (Params, error) {
+		var (
+			params Params
+			err    error
+		)
+		handler := func(_ http.ResponseWriter, r *http.Request) {
+			err = b.BindPath(r, &params)
+		}
+
+		switch router := router.(type) {
+		case *http.ServeMux:
+			router.HandleFunc("GET /posts/{id}/{slug}", handler)
+		case *chi.Mux:
+			router.Get("/posts/{id}/{slug}", handler)
+		case *mux.Router:
+			router.HandleFunc("/posts/{id}/{slug}", handler)
+		}
+
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, target, nil))
+		return params, err
+	}
+
+	t.Run("http.ServeMux", func(t *testing.T) {
+		params, err := serve(http.NewServeMux(), binder.New(), "/posts/42/hello-world")
+		require.NoError(t, err)
+		require.Equal(t, 42, params.ID)
+		require.Equal(t, "hello-world", params.Slug)
+	})
+
+	t.Run("chi", func(t *testing.T) {
+		b := binder.New(binder.WithPathParamExtractor(binder.ChiExtractor{}))
+		params, err := serve(chi.NewRouter(), b, "/posts/42/hello-world")
+		require.NoError(t, err)
+		require.Equal(t, 42, params.ID)
+		require.Equal(t, "hello-world", params.Slug)
+	})
+
+	t.Run("gorilla/mux", func(t *testing.T) {
+		b := binder.New(binder.WithPathParamExtractor(binder.GorillaMuxExtractor{}))
+		params, err := serve(mux.NewRouter(), b, "/posts/42/hello-world")
+		require.NoError(t, err)
+		require.Equal(t, 42, params.ID)
+		require.Equal(t, "hello-world", params.Slug)
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		_, err := serve(http.NewServeMux(), binder.New(), "/posts/abc/hello-world")
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrDecodePath)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/posts/42/hello-world", nil)
+
+		var params Params
+		err := binder.BindPath(req, params)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrInvalidInput)
+	})
+}
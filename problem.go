@@ -0,0 +1,168 @@
+package binder
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// ProblemContentType is the media type of the problem details document.
+const ProblemContentType = "application/problem+json"
+
+// Problem is the problem details document defined by RFC 9457.
+// It describes the binding failure in a machine-readable way.
+type Problem struct {
+	// Type is a URI reference that identifies the problem type.
+	// If it is empty, "about:blank" is assumed.
+	Type string `json:"type,omitempty"`
+	// Title is a short, human-readable summary of the problem type.
+	Title string `json:"title"`
+	// Status is the HTTP status code.
+	Status int `json:"status"`
+	// Detail is a human-readable explanation specific to this occurrence of the problem.
+	Detail string `json:"detail,omitempty"`
+	// Instance is a URI reference that identifies the specific occurrence of the problem.
+	Instance string `json:"instance,omitempty"`
+	// Errors is the extension member listing the field-level problems.
+	Errors []ProblemField `json:"errors,omitempty"`
+}
+
+// ProblemField describes a field-level problem of the problem details document.
+type ProblemField struct {
+	// Name is the name of the value in the request, e.g. the query parameter or header name.
+	Name string `json:"name"`
+	// Source is the part of the request the value was bound from.
+	// It is empty for validation errors.
+	Source Source `json:"source,omitempty"`
+	// Detail is a human-readable explanation of the problem.
+	Detail string `json:"detail"`
+}
+
+// problemDetails maps the binding errors to the problem detail messages,
+// in order of precedence.
+var problemDetails = []error{
+	ErrBodyTooLarge,
+	ErrUnsupportedEncoding,
+	ErrDecompressBody,
+	ErrInvalidMethod,
+	ErrInvalidContentType,
+	ErrEmptyBody,
+	ErrEmptyQuery,
+	ErrParseForm,
+	ErrDecodeForm,
+	ErrDecodeQuery,
+	ErrDecodeJSON,
+	ErrDecodeXML,
+	ErrDecodeYAML,
+	ErrDecodeTOML,
+	ErrDecodeMsgPack,
+	ErrDecodeCBOR,
+	ErrDecodeProto,
+	ErrDecodePath,
+	ErrDecodeHeader,
+	ErrDecodeCookie,
+	ErrInvalidCookieSig,
+	ErrValidation,
+	ErrGetFile,
+	ErrReadFile,
+	ErrGetFileMimeType,
+}
+
+// ErrorStatus returns the HTTP status code for the error returned by the binder:
+//   - 413 Request Entity Too Large for ErrBodyTooLarge;
+//   - 415 Unsupported Media Type for ErrInvalidContentType and ErrUnsupportedEncoding;
+//   - 405 Method Not Allowed for ErrInvalidMethod;
+//   - 500 Internal Server Error for invalid binding targets, configuration and storage failures, e.g. ErrInvalidInput;
+//   - 422 Unprocessable Entity for ErrValidation;
+//   - 400 Bad Request for any other error, e.g. decoding errors.
+func ErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrBodyTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, ErrInvalidContentType), errors.Is(err, ErrUnsupportedEncoding):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, ErrInvalidMethod):
+		return http.StatusMethodNotAllowed
+	case errors.Is(err, ErrInvalidInput),
+		errors.Is(err, ErrTargetMustBeAPointer),
+		errors.Is(err, ErrTargetMustBeAStruct),
+		errors.Is(err, ErrTargetMustBeAProto),
+		errors.Is(err, ErrEmptyCookieKey),
+		errors.Is(err, ErrSaveFile):
+		return http.StatusInternalServerError
+	case errors.Is(err, ErrValidation):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// NewProblem creates the problem details document for the error returned by the binder.
+// The status is chosen by ErrorStatus, and the field errors, if any,
+// are listed in the "errors" extension member.
+// It returns nil if err is nil.
+func NewProblem(err error) *Problem {
+	if err == nil {
+		return nil
+	}
+
+	status := ErrorStatus(err)
+	p := &Problem{
+		Title:  http.StatusText(status),
+		Status: status,
+	}
+
+	// Do not expose internal errors to the client
+	if status == http.StatusInternalServerError {
+		return p
+	}
+
+	for _, e := range problemDetails {
+		if errors.Is(err, e) {
+			p.Detail = e.Error()
+			break
+		}
+	}
+
+	var errs BindingErrors
+	if errors.As(err, &errs) {
+		for _, fieldErr := range errs {
+			p.Errors = append(p.Errors, ProblemField{
+				Name:   fieldErr.Tag,
+				Source: fieldErr.Source,
+				Detail: problemFieldDetail(fieldErr),
+			})
+		}
+	}
+
+	return p
+}
+
+// problemFieldDetail returns the client-facing explanation of the field error.
+// It hides the low-level conversion errors.
+func problemFieldDetail(err *FieldError) string {
+	switch {
+	case err.Err == nil:
+		return err.Error()
+	case errors.Is(err.Err, ErrInvalidValue):
+		if err.Type != "" {
+			return ErrInvalidValue.Error() + ", expected " + err.Type
+		}
+		return ErrInvalidValue.Error()
+	default:
+		return err.Err.Error()
+	}
+}
+
+// WriteProblem writes the problem details document for the error returned by the binder
+// to the response writer, with the application/problem+json content type and the matching status.
+func WriteProblem(w http.ResponseWriter, err error) error {
+	p := NewProblem(err)
+	if p == nil {
+		return nil
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	return json.NewEncoder(w).Encode(p)
+}
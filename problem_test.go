@@ -0,0 +1,96 @@
+package binder_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestErrorStatus(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+	}{
+		{fmt.Errorf("%w: text/plain", binder.ErrInvalidContentType), http.StatusUnsupportedMediaType},
+		{fmt.Errorf("%w: TRACE", binder.ErrInvalidMethod), http.StatusMethodNotAllowed},
+		{errors.Join(binder.ErrInvalidInput, binder.ErrTargetMustBeAPointer), http.StatusInternalServerError},
+		{errors.Join(binder.ErrDecodeJSON, errors.New("unexpected EOF")), http.StatusBadRequest},
+		{binder.ErrEmptyBody, http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.status, binder.ErrorStatus(test.err), test.err.Error())
+	}
+}
+
+func TestWriteProblem(t *testing.T) {
+	t.Run("field errors", func(t *testing.T) {
+		type Filter struct {
+			Page  int `query:"page"`
+			Limit int `query:"limit"`
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/?page=one&limit=10", nil)
+
+		var filter Filter
+		err := binder.BindQuery(req, &filter)
+		require.Error(t, err)
+
+		rec := httptest.NewRecorder()
+		require.NoError(t, binder.WriteProblem(rec, err))
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Equal(t, binder.ProblemContentType, rec.Header().Get("Content-Type"))
+
+		var problem binder.Problem
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&problem))
+		require.Equal(t, binder.Problem{
+			Title:  "Bad Request",
+			Status: http.StatusBadRequest,
+			Detail: binder.ErrDecodeQuery.Error(),
+			Errors: []binder.ProblemField{
+				{Name: "page", Source: binder.SourceQuery, Detail: "invalid value, expected int"},
+			},
+		}, problem)
+	})
+
+	t.Run("invalid content type", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.Header.Set("Content-Type", "text/plain")
+
+		var payload struct{}
+		err := binder.BindFunc(req, &payload)
+		require.Error(t, err)
+
+		problem := binder.NewProblem(err)
+		require.Equal(t, http.StatusUnsupportedMediaType, problem.Status)
+		require.Equal(t, "Unsupported Media Type", problem.Title)
+		require.Equal(t, binder.ErrInvalidContentType.Error(), problem.Detail)
+		require.Empty(t, problem.Errors)
+	})
+
+	t.Run("internal error is not exposed", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/?page=1", nil)
+
+		var payload struct{}
+		err := binder.BindQuery(req, payload)
+		require.Error(t, err)
+
+		problem := binder.NewProblem(err)
+		require.Equal(t, http.StatusInternalServerError, problem.Status)
+		require.Empty(t, problem.Detail)
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		require.NoError(t, binder.WriteProblem(rec, nil))
+		require.Nil(t, binder.NewProblem(nil))
+		require.Equal(t, 0, rec.Body.Len())
+	})
+}
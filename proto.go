@@ -0,0 +1,68 @@
+package binder
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// BindProto binds the passed protobuf message to the request using the default binder instance.
+// See Instance.BindProto for details.
+func BindProto(r *http.Request, msg proto.Message) error {
+	return defaultInstance.BindProto(r, msg)
+}
+
+// BindProto binds the passed protobuf message to the request.
+// It uses the application/x-protobuf content type for binding.
+// The JSON body of the message is bound by BindJSON with the protojson semantics.
+// The bound value is validated afterwards, see WithValidator.
+func (b *Instance) BindProto(r *http.Request, msg proto.Message) error {
+	if err := b.decodeBody(r, msg, b.bindProto); err != nil {
+		return err
+	}
+	return b.validate(msg)
+}
+
+// bindProto binds the passed v pointer to the request protobuf body without validation.
+// v must implement the proto.Message interface.
+func (b *Instance) bindProto(r *http.Request, v interface{}) error {
+	// Check the request and the v pointer before decoding the body into it
+	if err := checkBodyRequest(r, v, MIMEApplicationXProtobuf, MIMEApplicationProtobuf); err != nil {
+		return err
+	}
+
+	// Check if the v pointer is a protobuf message
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return errors.Join(ErrInvalidInput, ErrTargetMustBeAProto)
+	}
+
+	// Read the request body, protobuf can't be decoded from a stream
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return errors.Join(ErrDecodeProto, err)
+	}
+
+	// Decode the request body into the message
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return errors.Join(ErrDecodeProto, err)
+	}
+
+	return nil
+}
+
+// decodeProtoJSON decodes the JSON body into the protobuf message using the protojson semantics:
+// lowerCamelCase field names, well-known types, etc. Unknown fields are ignored unless strict is true.
+func decodeProtoJSON(r *http.Request, msg proto.Message, strict bool) error {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return errors.Join(ErrDecodeJSON, err)
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: !strict}).Unmarshal(data, msg); err != nil {
+		return errors.Join(ErrDecodeJSON, err)
+	}
+	return nil
+}
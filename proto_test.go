@@ -0,0 +1,78 @@
+package binder_test
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/apipb"
+	"google.golang.org/protobuf/types/known/sourcecontextpb"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestBindProto(t *testing.T) {
+	// new protobuf request with body and content type
+	newProtoRequest := func(method, contentType string, body []byte) *http.Request {
+		req, err := http.NewRequest(method, "/", bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		return req
+	}
+
+	payload, err := proto.Marshal(&apipb.Api{
+		Name:          "binder",
+		Version:       "v1",
+		SourceContext: &sourcecontextpb.SourceContext{FileName: "binder.proto"},
+	})
+	require.NoError(t, err)
+
+	t.Run("invalid method", func(t *testing.T) {
+		err := binder.BindProto(newProtoRequest(http.MethodGet, "application/x-protobuf", payload), &apipb.Api{})
+		require.ErrorIs(t, err, binder.ErrInvalidMethod)
+	})
+
+	t.Run("invalid content type", func(t *testing.T) {
+		err := binder.BindProto(newProtoRequest(http.MethodPost, "text/plain", payload), &apipb.Api{})
+		require.ErrorIs(t, err, binder.ErrInvalidContentType)
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		err := binder.BindProto(newProtoRequest(http.MethodPost, "application/x-protobuf", payload[:5]), &apipb.Api{})
+		require.ErrorIs(t, err, binder.ErrDecodeProto)
+	})
+
+	t.Run("not a proto message", func(t *testing.T) {
+		var obj struct{}
+		err := binder.BindFunc(newProtoRequest(http.MethodPost, "application/x-protobuf", payload), &obj)
+		require.ErrorIs(t, err, binder.ErrTargetMustBeAProto)
+	})
+
+	t.Run("successful case", func(t *testing.T) {
+		for _, contentType := range []string{"application/x-protobuf", "application/protobuf"} {
+			msg := &apipb.Api{}
+			err := binder.BindFunc(newProtoRequest(http.MethodPost, contentType, payload), msg)
+			require.NoError(t, err)
+			require.Equal(t, "binder", msg.GetName())
+			require.Equal(t, "v1", msg.GetVersion())
+			require.Equal(t, "binder.proto", msg.GetSourceContext().GetFileName())
+		}
+	})
+
+	t.Run("protojson", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(
+			`{"name":"binder","sourceContext":{"fileName":"binder.proto"},"unknown":true}`,
+		))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		msg := &apipb.Api{}
+		err = binder.BindJSON(req, msg)
+		require.NoError(t, err)
+		require.Equal(t, "binder", msg.GetName())
+		require.Equal(t, "binder.proto", msg.GetSourceContext().GetFileName())
+	})
+}
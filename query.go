@@ -6,11 +6,32 @@ import (
 	"net/http"
 )
 
+// BindQuery binds the passed v pointer to the request using the default binder instance.
+// See Instance.BindQuery for details.
+// Implements the binder.BinderFunc interface.
+func BindQuery(r *http.Request, v interface{}) error {
+	return defaultInstance.BindQuery(r, v)
+}
+
 // BindQuery binds the passed v pointer to the request.
 // It uses the query string for binding.
 // `v` param should be a pointer to a struct with `query“ tags.
-// Implements the binder.BinderFunc interface.
-func BindQuery(r *http.Request, v interface{}) error {
+// The time.Time values are parsed as RFC 3339 unless the layout, unix or unixmilli tag option is set,
+// e.g. `query:"since,layout=2006-01-02"`, and time.Duration values are parsed with time.ParseDuration.
+// Absent parameters are set to the value of the `default` struct tag, if any, see WithDefaultOnEmpty.
+// ErrEmptyQuery is returned for the empty query string unless there are default values to set or required parameters.
+// Absent or empty parameters with the required tag option, e.g. `query:"page,required"`,
+// are reported as field errors wrapping ErrMissingField.
+// The bound value is validated afterwards, see WithValidator.
+func (b *Instance) BindQuery(r *http.Request, v interface{}) error {
+	if err := b.bindQuery(r, v); err != nil {
+		return err
+	}
+	return b.validate(v)
+}
+
+// bindQuery binds the passed v pointer to the request query string without validation.
+func (b *Instance) bindQuery(r *http.Request, v interface{}) error {
 	// Check if the request method is GET, HEAD or DELETE
 	if !isGetHeadOptionDelete(r) {
 		return fmt.Errorf("%w: %s", ErrInvalidMethod, r.Method)
@@ -21,15 +42,26 @@ func BindQuery(r *http.Request, v interface{}) error {
 		return errors.Join(ErrInvalidInput, ErrTargetMustBeAPointer)
 	}
 
-	// Check if the request query is empty
-	if r.URL.RawQuery == "" {
+	// Check if the request query is empty, the empty query is still decoded
+	// to set the default values and report the missing required ones
+	if r.URL.RawQuery == "" && !b.bindsEmptyQuery(v) {
 		return ErrEmptyQuery
 	}
 
-	// Decode the request query into the v pointer and handle decoding errors
-	if err := queryDecoder.Decode(v, r.URL.Query()); err != nil {
+	return b.decodeQuery(r, v)
+}
+
+// bindsEmptyQuery reports whether the empty query string is decoded into the v pointer,
+// as the default values are set to its fields or some of them are required.
+func (b *Instance) bindsEmptyQuery(v interface{}) bool {
+	return hasDefaults(v, b.queryTag) || hasRequired(v, b.queryTag)
+}
+
+// decodeQuery decodes the request query into the v pointer and handles decoding errors.
+func (b *Instance) decodeQuery(r *http.Request, v interface{}) error {
+	query := r.URL.Query()
+	if err := b.decodeValues(b.queryDecoder, v, b.queryTag, SourceQuery, query); err != nil {
 		return errors.Join(ErrDecodeQuery, err)
 	}
-
 	return nil
 }
@@ -0,0 +1,51 @@
+package binder
+
+import "reflect"
+
+// isMissing reports whether the values are absent or empty.
+func isMissing(values []string) bool {
+	for _, value := range values {
+		if value != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// hasRequired reports whether any field with the given tag of the struct that v points to is required.
+func hasRequired(v interface{}, tag string) bool {
+	if reflect.TypeOf(v).Elem().Kind() != reflect.Struct {
+		return false
+	}
+	for _, field := range taggedFields(v, tag) {
+		if field.hasOption(OptionRequired) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRequired returns the missing field errors of the required fields of the struct that v points to,
+// which are absent or empty in the source map. The fields are required with the required tag option,
+// e.g. `query:"page,required"`. The Upload fields are skipped, as they are not bound from the source values,
+// see checkRequiredUploads.
+func checkRequired(v interface{}, tag string, source Source, src map[string][]string) BindingErrors {
+	var errs BindingErrors
+	for _, field := range taggedFields(v, tag) {
+		if field.hasOption(OptionRequired) && !isUploadType(field.typ) && isMissing(src[field.name]) {
+			errs = append(errs, missingFieldError(v, tag, source, field))
+		}
+	}
+	return errs
+}
+
+// missingFieldError returns the missing field error of the field of the struct that v points to.
+func missingFieldError(v interface{}, tag string, source Source, field taggedField) *FieldError {
+	return &FieldError{
+		Field:  fieldPath(reflect.TypeOf(v).Elem(), tag, field.name),
+		Source: source,
+		Tag:    field.name,
+		Type:   field.typ.String(),
+		Err:    ErrMissingField,
+	}
+}
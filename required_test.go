@@ -0,0 +1,133 @@
+package binder_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestRequired(t *testing.T) {
+	type Params struct {
+		Page   int    `query:"page,required" form:"page,required"`
+		Email  string `query:"email,required" form:"email,required"`
+		Limit  int    `query:"limit,required" form:"limit,required" default:"20"`
+		Tenant string `header:"X-Tenant,required"`
+		Search string `query:"q" form:"q"`
+	}
+
+	// requireMissing checks that the error reports exactly the given fields as missing
+	requireMissing := func(t *testing.T, err error, source binder.Source, fields ...string) {
+		t.Helper()
+		require.ErrorIs(t, err, binder.ErrMissingField)
+
+		var errs binder.BindingErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, len(fields))
+		for i, field := range fields {
+			require.Equal(t, field, errs[i].Field)
+			require.Equal(t, source, errs[i].Source)
+			require.ErrorIs(t, errs[i], binder.ErrMissingField)
+		}
+	}
+
+	t.Run("query", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/?q=go&email=", nil)
+
+		var params Params
+		err := binder.BindQuery(req, &params)
+		require.ErrorIs(t, err, binder.ErrDecodeQuery)
+		requireMissing(t, err, binder.SourceQuery, "Email", "Page")
+		require.Equal(t, "go", params.Search)
+		require.Equal(t, 20, params.Limit, "the default value satisfies the required field")
+		require.Equal(t, http.StatusBadRequest, binder.ErrorStatus(err))
+		require.Contains(t, err.Error(), `query "page" (int): missing`)
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/items", nil)
+
+		var params Params
+		err := binder.BindQuery(req, &params)
+		require.NotErrorIs(t, err, binder.ErrEmptyQuery)
+		requireMissing(t, err, binder.SourceQuery, "Email", "Page")
+
+		var all struct {
+			Page int `query:"page,required"`
+		}
+		err = binder.BindAll(req, &all)
+		require.ErrorIs(t, err, binder.ErrDecodeQuery)
+		requireMissing(t, err, binder.SourceQuery, "Page")
+	})
+
+	t.Run("query with all fields", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/?page=1&email=john@example.com", nil)
+
+		var params Params
+		require.NoError(t, binder.BindQuery(req, &params))
+		require.Equal(t, 1, params.Page)
+		require.Equal(t, "john@example.com", params.Email)
+	})
+
+	t.Run("form", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("page=1&limit="))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		var params Params
+		err := binder.BindForm(req, &params)
+		require.ErrorIs(t, err, binder.ErrDecodeForm)
+		requireMissing(t, err, binder.SourceForm, "Email", "Limit")
+	})
+
+	t.Run("multipart", func(t *testing.T) {
+		type Upload struct {
+			Title  string       `form:"title,required"`
+			Avatar *binder.File `form:"avatar,required"`
+			Note   string       `form:"note"`
+		}
+
+		title := [][2]string{{"title", "Photo"}}
+		req, err := newMultipartRequest(http.MethodPost, "/", title, nil, nil)
+		require.NoError(t, err)
+
+		var upload Upload
+		err = binder.BindFormMultipart(req, &upload)
+		requireMissing(t, err, binder.SourceForm, "Avatar")
+
+		upload = Upload{}
+		req, err = newMultipartRequest(http.MethodPost, "/", title, []multipartFile{{"avatar", "a.txt", []byte("hello")}}, nil)
+		require.NoError(t, err)
+		require.NoError(t, binder.BindFormMultipart(req, &upload))
+		require.Equal(t, "Photo", upload.Title)
+		require.NotNil(t, upload.Avatar)
+	})
+
+	t.Run("header", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		var params Params
+		err := binder.BindHeader(req, &params)
+		require.ErrorIs(t, err, binder.ErrDecodeHeader)
+		requireMissing(t, err, binder.SourceHeader, "Tenant")
+
+		req.Header.Set("X-Tenant", "acme")
+		require.NoError(t, binder.BindHeader(req, &params))
+		require.Equal(t, "acme", params.Tenant)
+	})
+
+	t.Run("problem details", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/?email=john@example.com", nil)
+
+		var params Params
+		p := binder.NewProblem(binder.BindQuery(req, &params))
+		require.NotNil(t, p)
+		require.Equal(t, http.StatusBadRequest, p.Status)
+		require.Equal(t, []binder.ProblemField{
+			{Name: "page", Source: binder.SourceQuery, Detail: "missing"},
+		}, p.Errors)
+	})
+}
@@ -0,0 +1,182 @@
+package binder
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// parseTime parses the time value according to the field tag options.
+// The value is parsed as RFC 3339 by default, see OptionLayout, OptionUnix and OptionUnixMilli.
+// Values without a time zone are parsed in the binder location, see WithTimeLocation.
+func (b *Instance) parseTime(value string, options []string) (time.Time, error) {
+	layout := time.RFC3339
+	for _, option := range options {
+		key, optionValue, _ := strings.Cut(strings.TrimSpace(option), "=")
+		switch key {
+		case OptionUnix:
+			sec, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return time.Unix(sec, 0).In(b.location), nil
+		case OptionUnixMilli:
+			msec, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return time.UnixMilli(msec).In(b.location), nil
+		case OptionLayout:
+			layout = optionValue
+		}
+	}
+	return time.ParseInLocation(layout, value, b.location)
+}
+
+// convertTimeValues converts the values of the time.Time fields of the struct that v points to into RFC 3339,
+// the format the schema decoders parse time.Time in, honouring the field tag options.
+// The fields of the nested structs and their slices are converted as well, e.g. for the "period.from" key.
+// The source map is copied if any value is converted. The values that cannot be parsed
+// are removed from the returned map and reported as field errors.
+func (b *Instance) convertTimeValues(v interface{}, tag string, source Source, src map[string][]string) (map[string][]string, BindingErrors) {
+	var errs BindingErrors
+	values := src
+	copied := false
+	typ := reflect.TypeOf(v).Elem()
+	for _, key := range sortedValueKeys(src) {
+		field, ok := keyField(typ, tag, key)
+		if !ok || !isTimeType(field.typ) || len(src[key]) == 0 {
+			continue
+		}
+
+		converted := make([]string, len(src[key]))
+		var err error
+		for i, value := range src[key] {
+			if value == "" {
+				continue
+			}
+			var t time.Time
+			if t, err = b.parseTime(value, field.options); err != nil {
+				errs = append(errs, &FieldError{
+					Field:  fieldPath(typ, tag, key),
+					Source: source,
+					Tag:    key,
+					Value:  value,
+					Type:   field.typ.String(),
+					Err:    fmt.Errorf("%w: %w", ErrInvalidValue, err),
+				})
+				break
+			}
+			converted[i] = t.Format(time.RFC3339Nano)
+		}
+
+		// Copy the source map before the first change, so the request values are kept intact
+		if !copied {
+			values = make(map[string][]string, len(src))
+			for key, value := range src {
+				values[key] = value
+			}
+			copied = true
+		}
+		delete(values, key)
+		switch {
+		case err != nil:
+		case field.typ.Kind() == reflect.Slice:
+			// The schema decoders bind slices of structs from the indexed keys only, e.g. "dates.0"
+			for i, value := range converted {
+				values[key+"."+strconv.Itoa(i)] = []string{value}
+			}
+		default:
+			values[key] = converted
+		}
+	}
+	return values, errs
+}
+
+// keyField returns the field the dot-separated source key is decoded into, e.g. "period.from" or "items.0.date",
+// resolved the same way as in fieldPath. The field type is the element type for the indexed keys,
+// and the options are the tag options of the last struct field on the path.
+func keyField(t reflect.Type, tag, key string) (taggedField, bool) {
+	var field taggedField
+	for _, part := range strings.Split(key, ".") {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array:
+			if _, err := strconv.Atoi(part); err != nil {
+				return taggedField{}, false
+			}
+			t = t.Elem()
+		case reflect.Struct:
+			structField, ok := fieldByAlias(t, tag, part)
+			if !ok {
+				return taggedField{}, false
+			}
+			parts := strings.Split(structField.Tag.Get(tag), ",")
+			field = taggedField{name: key, options: parts[1:], structTag: structField.Tag}
+			t = structField.Type
+		default:
+			return taggedField{}, false
+		}
+	}
+	field.typ = t
+	return field, true
+}
+
+// sortedValueKeys returns the keys of the source values in sorted order.
+func sortedValueKeys(src map[string][]string) []string {
+	keys := make([]string, 0, len(src))
+	for key := range src {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isTimeType reports whether the type is time.Time, or a pointer or slice of it.
+func isTimeType(t reflect.Type) bool {
+	if t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t == timeType
+}
+
+// convertTime is the schema decoder converter of time.Time values.
+// The values are converted into RFC 3339 in advance, see convertTimeValues.
+// Empty values are converted to the zero time.
+func (b *Instance) convertTime(value string) reflect.Value {
+	if value == "" {
+		return reflect.ValueOf(time.Time{})
+	}
+	t, err := time.ParseInLocation(time.RFC3339Nano, value, b.location)
+	if err != nil {
+		return reflect.Value{}
+	}
+	return reflect.ValueOf(t)
+}
+
+// convertDuration is the schema decoder converter of time.Duration values, e.g. "1h30m".
+// Empty values are converted to zero.
+func convertDuration(value string) reflect.Value {
+	if value == "" {
+		return reflect.ValueOf(time.Duration(0))
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return reflect.Value{}
+	}
+	return reflect.ValueOf(d)
+}
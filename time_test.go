@@ -0,0 +1,188 @@
+package binder_test
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestTimeBinding(t *testing.T) {
+	type Filter struct {
+		Since   time.Time     `query:"since" form:"since"`
+		Day     time.Time     `query:"day,layout=2006-01-02" form:"day,layout=2006-01-02"`
+		Created time.Time     `query:"created,unix" form:"created,unix"`
+		Updated *time.Time    `query:"updated,unixmilli" form:"updated,unixmilli"`
+		Timeout time.Duration `query:"timeout" form:"timeout"`
+		Dates   []time.Time   `query:"dates,layout=2006-01-02" form:"dates,layout=2006-01-02"`
+	}
+
+	values := map[string]string{
+		"since":   "2024-03-01T10:00:00+02:00",
+		"day":     "2024-03-01",
+		"created": "1709287200",
+		"updated": "1709287200500",
+		"timeout": "1m30s",
+		"dates":   "2024-03-02",
+	}
+
+	// the expected filter for the values parsed in the given location
+	expected := func(loc *time.Location) Filter {
+		updated := time.UnixMilli(1709287200500).In(loc)
+		return Filter{
+			Since:   time.Date(2024, 3, 1, 10, 0, 0, 0, time.FixedZone("", 2*60*60)),
+			Day:     time.Date(2024, 3, 1, 0, 0, 0, 0, loc),
+			Created: time.Unix(1709287200, 0).In(loc),
+			Updated: &updated,
+			Timeout: 90 * time.Second,
+			Dates:   []time.Time{time.Date(2024, 3, 2, 0, 0, 0, 0, loc)},
+		}
+	}
+
+	// requireFilter compares the times by the instant and the offset
+	requireFilter := func(t *testing.T, want, got Filter) {
+		t.Helper()
+		require.True(t, want.Since.Equal(got.Since), got.Since)
+		require.True(t, want.Day.Equal(got.Day), got.Day)
+		_, wantOffset := want.Day.Zone()
+		_, gotOffset := got.Day.Zone()
+		require.Equal(t, wantOffset, gotOffset)
+		require.True(t, want.Created.Equal(got.Created), got.Created)
+		require.NotNil(t, got.Updated)
+		require.True(t, want.Updated.Equal(*got.Updated), got.Updated)
+		require.Equal(t, want.Timeout, got.Timeout)
+		require.Len(t, got.Dates, 1)
+		require.True(t, want.Dates[0].Equal(got.Dates[0]), got.Dates[0])
+	}
+
+	newMultipartRequest := func(values map[string]string) *http.Request {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		for key, value := range values {
+			require.NoError(t, writer.WriteField(key, value))
+		}
+		require.NoError(t, writer.Close())
+
+		req := httptest.NewRequest(http.MethodPost, "/", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		return req
+	}
+
+	t.Run("query", func(t *testing.T) {
+		query := make(map[string]interface{}, len(values))
+		for key, value := range values {
+			query[key] = value
+		}
+		req, err := newQueryRequest(http.MethodGet, "/", query, nil)
+		require.NoError(t, err)
+
+		var filter Filter
+		require.NoError(t, binder.BindQuery(req, &filter))
+		requireFilter(t, expected(time.UTC), filter)
+	})
+
+	t.Run("form", func(t *testing.T) {
+		form := make(map[string]interface{}, len(values))
+		for key, value := range values {
+			form[key] = value
+		}
+		req, err := newFormRequest(http.MethodPost, "/", form, nil)
+		require.NoError(t, err)
+
+		var filter Filter
+		require.NoError(t, binder.BindForm(req, &filter))
+		requireFilter(t, expected(time.UTC), filter)
+		require.Equal(t, "1709287200", req.PostForm.Get("created"), "request values must be kept intact")
+	})
+
+	t.Run("multipart", func(t *testing.T) {
+		var filter Filter
+		require.NoError(t, binder.BindFormMultipart(newMultipartRequest(values), &filter))
+		requireFilter(t, expected(time.UTC), filter)
+	})
+
+	t.Run("time location", func(t *testing.T) {
+		loc := time.FixedZone("UTC+3", 3*60*60)
+		b := binder.New(binder.WithTimeLocation(loc))
+
+		var filter Filter
+		require.NoError(t, b.BindFormMultipart(newMultipartRequest(values), &filter))
+		requireFilter(t, expected(loc), filter)
+		require.Equal(t, loc, filter.Created.Location())
+
+		req := httptest.NewRequest(http.MethodGet, "/?day=2024-03-01", nil)
+		filter = Filter{}
+		require.NoError(t, b.BindQuery(req, &filter))
+		require.True(t, time.Date(2024, 3, 1, 0, 0, 0, 0, loc).Equal(filter.Day), filter.Day)
+	})
+
+	t.Run("nested structs", func(t *testing.T) {
+		type Period struct {
+			From time.Time `query:"from,layout=2006-01-02"`
+			To   time.Time `query:"to,unix"`
+		}
+		type Report struct {
+			Period Period    `query:"period"`
+			Events []*Period `query:"events"`
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/?period.from=2024-03-01&period.to=1709287200&events.0.from=2024-03-02&events.1.from=day", nil)
+
+		var report Report
+		err := binder.BindQuery(req, &report)
+		require.ErrorIs(t, err, binder.ErrInvalidValue)
+
+		var errs binder.BindingErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 1)
+		require.Equal(t, "Events[1].From", errs[0].Field)
+		require.Equal(t, "events.1.from", errs[0].Tag)
+
+		require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), report.Period.From)
+		require.True(t, time.Unix(1709287200, 0).Equal(report.Period.To), report.Period.To)
+		require.NotEmpty(t, report.Events)
+		require.Equal(t, time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), report.Events[0].From)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/?day=01.03.2024&timeout=soon&since=2024-03-01T10:00:00Z", nil)
+
+		var filter Filter
+		err := binder.BindQuery(req, &filter)
+		require.ErrorIs(t, err, binder.ErrDecodeQuery)
+		require.ErrorIs(t, err, binder.ErrInvalidValue)
+
+		var errs binder.BindingErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 2)
+		require.Equal(t, "Day", errs[0].Field)
+		require.Equal(t, "01.03.2024", errs[0].Value)
+		require.Equal(t, "time.Time", errs[0].Type)
+		require.Equal(t, "Timeout", errs[1].Field)
+		require.Equal(t, "soon", errs[1].Value)
+
+		// the valid values are bound anyway
+		require.Equal(t, time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC), filter.Since)
+	})
+
+	t.Run("invalid multipart values", func(t *testing.T) {
+		var filter Filter
+		err := binder.BindFormMultipart(newMultipartRequest(map[string]string{
+			"created": "yesterday",
+			"timeout": "10",
+		}), &filter)
+		require.ErrorIs(t, err, binder.ErrDecodeForm)
+
+		var errs binder.BindingErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 2)
+		require.Equal(t, "Created", errs[0].Field)
+		require.Equal(t, "Timeout", errs[1].Field)
+	})
+}
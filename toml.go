@@ -0,0 +1,55 @@
+package binder
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// BindTOML binds the passed v pointer to the request using the default binder instance.
+// See Instance.BindTOML for details.
+// Implements the binder.BinderFunc interface.
+func BindTOML(r *http.Request, v interface{}) error {
+	return defaultInstance.BindTOML(r, v)
+}
+
+// BindTOML binds the passed v pointer to the request.
+// It uses the application/toml content type for binding.
+// `v` param should be a pointer to a struct with `toml` tags.
+// If the struct has no `toml` tags, its `json` tags are used instead,
+// so the same struct can be bound from JSON and TOML.
+// The bound value is validated afterwards, see WithValidator.
+func (b *Instance) BindTOML(r *http.Request, v interface{}) error {
+	if err := b.decodeBody(r, v, b.bindTOML); err != nil {
+		return err
+	}
+	return b.validate(v)
+}
+
+// bindTOML binds the passed v pointer to the request TOML body without validation.
+func (b *Instance) bindTOML(r *http.Request, v interface{}) error {
+	// Check the request and the v pointer before decoding the body into it
+	if err := checkBodyRequest(r, v, MIMEApplicationTOML); err != nil {
+		return err
+	}
+
+	// Decode the request body straight into the v pointer if it has toml tags
+	if usedTags(v, "toml")["toml"] {
+		if err := toml.NewDecoder(r.Body).Decode(v); err != nil {
+			return errors.Join(ErrDecodeTOML, err)
+		}
+		return nil
+	}
+
+	// Otherwise decode the body into a generic value and bind it with the json tags
+	var doc map[string]interface{}
+	if err := toml.NewDecoder(r.Body).Decode(&doc); err != nil {
+		return errors.Join(ErrDecodeTOML, err)
+	}
+	if err := rebindJSON(doc, v); err != nil {
+		return errors.Join(ErrDecodeTOML, err)
+	}
+
+	return nil
+}
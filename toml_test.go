@@ -0,0 +1,71 @@
+package binder_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestBindTOML(t *testing.T) {
+	// new toml request with body and content type
+	newTOMLRequest := func(method, body string) *http.Request {
+		req, err := http.NewRequest(method, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/toml")
+		return req
+	}
+
+	payload := "field_one = \"value\"\nfield_two = 123\n\n[server]\nport = 8080\n"
+
+	t.Run("invalid method", func(t *testing.T) {
+		var obj struct{}
+		err := binder.BindTOML(newTOMLRequest(http.MethodGet, payload), &obj)
+		require.ErrorIs(t, err, binder.ErrInvalidMethod)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		var obj struct{}
+		err := binder.BindTOML(newTOMLRequest(http.MethodPost, payload), obj)
+		require.ErrorIs(t, err, binder.ErrInvalidInput)
+	})
+
+	t.Run("toml tags", func(t *testing.T) {
+		var obj struct {
+			FieldOne string `toml:"field_one"`
+			FieldTwo int    `toml:"field_two"`
+			Server   struct {
+				Port int `toml:"port"`
+			} `toml:"server"`
+		}
+		err := binder.BindTOML(newTOMLRequest(http.MethodPost, payload), &obj)
+		require.NoError(t, err)
+		require.Equal(t, "value", obj.FieldOne)
+		require.Equal(t, 123, obj.FieldTwo)
+		require.Equal(t, 8080, obj.Server.Port)
+	})
+
+	t.Run("json tags", func(t *testing.T) {
+		var obj struct {
+			FieldOne string `json:"field_one"`
+			FieldTwo int    `json:"field_two"`
+			Server   struct {
+				Port int `json:"port"`
+			} `json:"server"`
+		}
+		err := binder.BindFunc(newTOMLRequest(http.MethodPatch, payload), &obj)
+		require.NoError(t, err)
+		require.Equal(t, "value", obj.FieldOne)
+		require.Equal(t, 123, obj.FieldTwo)
+		require.Equal(t, 8080, obj.Server.Port)
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		var obj struct{}
+		err := binder.BindTOML(newTOMLRequest(http.MethodPost, "field_one = "), &obj)
+		require.ErrorIs(t, err, binder.ErrDecodeTOML)
+	})
+}
@@ -0,0 +1,252 @@
+package binder
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gabriel-vasile/mimetype"
+)
+
+// mimeSniffLen is the number of bytes read from the upload head to detect its MIME type.
+const mimeSniffLen = 3072
+
+// Upload represents a file that is being uploaded via a multipart form.
+// Unlike File, it does not hold the file contents in memory:
+// the contents are streamed from the request body by the reader returned by Open.
+type Upload struct {
+	// FileName stores the name of the file
+	FileName string
+	// ContentType stores the MIME type of the file, detected from the first few KB of its contents
+	ContentType string
+	// Header stores the MIME header of the multipart part
+	Header textproto.MIMEHeader
+
+	stream *uploadStream
+}
+
+// uploadStream is the shared state of the upload, so it can be copied by value.
+type uploadStream struct {
+	mu     sync.Mutex
+	reader io.Reader
+	part   *multipart.Part
+	opened bool
+}
+
+// Open returns the reader of the file contents.
+// The contents are read straight from the request body, so Open can be called only once,
+// and the reader must be consumed before the handler returns.
+func (u *Upload) Open() (io.ReadCloser, error) {
+	if u.stream == nil {
+		return nil, ErrGetFile
+	}
+
+	u.stream.mu.Lock()
+	defer u.stream.mu.Unlock()
+	if u.stream.opened {
+		return nil, fmt.Errorf("%w: upload is already opened", ErrGetFile)
+	}
+	u.stream.opened = true
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{u.stream.reader, u.stream.part}, nil
+}
+
+// BindFormMultipartStream binds the passed v pointer to the request using the default binder instance.
+// See Instance.BindFormMultipartStream for details.
+// Implements the binder.BinderFunc interface.
+func BindFormMultipartStream(r *http.Request, v interface{}) error {
+	return defaultInstance.BindFormMultipartStream(r, v)
+}
+
+// BindFormMultipartStream binds the passed v pointer to the request without loading files into memory.
+// It uses the multipart/form-data content type for binding.
+// `v` param should be a pointer to a struct with `form` tags,
+// files are bound to the fields of the Upload or *Upload type.
+//
+// The request body is read part by part with http.Request.MultipartReader.
+// The form values are bound the same way as in BindForm. Binding stops at the first file
+// bound to an Upload field, and its contents are streamed by the reader returned by Upload.Open.
+//
+// The parts must be ordered: all the form values first, then the file as the last part of the form.
+// The parts after the file are not read, so the values sent after it are left unbound,
+// or reported as missing if they are required. As only one file can be streamed,
+// the v pointer must have at most one Upload field, ErrInvalidInput is returned otherwise.
+// The bound value is validated afterwards, see WithValidator.
+func (b *Instance) BindFormMultipartStream(r *http.Request, v interface{}) error {
+	if err := b.decodeBody(r, v, b.bindFormMultipartStream); err != nil {
+		return err
+	}
+	return b.validate(v)
+}
+
+// bindFormMultipartStream binds the passed v pointer to the request multipart stream without validation.
+func (b *Instance) bindFormMultipartStream(r *http.Request, v interface{}) error {
+	// Check the request and the v pointer before reading the body
+	if err := checkBodyRequest(r, v, MIMEMultipartForm); err != nil {
+		return err
+	}
+
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return errors.Join(ErrParseForm, err)
+	}
+
+	// Find the upload fields by the form tag, the fields after the first streamed file would never be reached
+	targetElem := reflect.ValueOf(v).Elem()
+	uploads := uploadFields(targetElem, b.formTag)
+	if len(uploads) > 1 {
+		names := make([]string, 0, len(uploads))
+		for name := range uploads {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("%w: only one upload field can be streamed, got %s", ErrInvalidInput, strings.Join(names, ", "))
+	}
+
+	// Read the parts until the first upload, collecting the form values
+	values := make(url.Values)
+	bound := make(map[string]bool, len(uploads))
+	remaining := b.maxMemory()
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return errors.Join(ErrParseForm, err)
+		}
+
+		name := part.FormName()
+		if name == "" {
+			continue
+		}
+
+		// Bind the file to the upload field and stop reading the body
+		if part.FileName() != "" {
+			field, ok := uploads[name]
+			if !ok {
+				continue
+			}
+			upload, err := newUpload(part)
+			if err != nil {
+				return err
+			}
+			setUpload(field, upload)
+			bound[name] = true
+			break
+		}
+
+		// Read the form value, limiting the total size of the values kept in memory
+		value, err := io.ReadAll(io.LimitReader(part, remaining+1))
+		if err != nil {
+			return errors.Join(ErrParseForm, err)
+		}
+		if remaining -= int64(len(value)); remaining < 0 {
+			return errors.Join(ErrParseForm, multipart.ErrMessageTooLarge)
+		}
+		values.Add(name, string(value))
+	}
+
+	// Decode the form values into the v pointer and handle decoding errors,
+	// the required uploads are checked against the files actually read
+	errs := checkRequiredUploads(v, b.formTag, bound)
+	if err := b.decodeValues(b.formDecoder, v, b.formTag, SourceForm, values); err != nil {
+		var decodeErrs BindingErrors
+		if !errors.As(err, &decodeErrs) {
+			return errors.Join(ErrDecodeForm, err)
+		}
+		errs = append(errs, decodeErrs...)
+	}
+	if len(errs) > 0 {
+		sort.SliceStable(errs, func(i, j int) bool {
+			return errs[i].Tag < errs[j].Tag
+		})
+		return errors.Join(ErrDecodeForm, errs)
+	}
+
+	return nil
+}
+
+// checkRequiredUploads returns the missing field errors of the required Upload fields
+// of the struct that v points to, the files of which were not bound.
+func checkRequiredUploads(v interface{}, tag string, bound map[string]bool) BindingErrors {
+	var errs BindingErrors
+	for _, field := range taggedFields(v, tag) {
+		if field.hasOption(OptionRequired) && isUploadType(field.typ) && !bound[field.name] {
+			errs = append(errs, missingFieldError(v, tag, SourceForm, field))
+		}
+	}
+	return errs
+}
+
+// newUpload creates the upload of the multipart part.
+// It detects the MIME type of the file by its first few KB, without reading the whole part.
+func newUpload(part *multipart.Part) (*Upload, error) {
+	reader := bufio.NewReaderSize(part, mimeSniffLen)
+	mime, err := sniffMimeType(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Upload{
+		FileName:    part.FileName(),
+		ContentType: mime,
+		Header:      part.Header,
+		stream:      &uploadStream{reader: reader, part: part},
+	}, nil
+}
+
+// sniffMimeType detects the MIME type of the file by its first few KB,
+// without consuming the reader.
+func sniffMimeType(reader *bufio.Reader) (string, error) {
+	head, err := reader.Peek(mimeSniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", errors.Join(ErrReadFile, err)
+	}
+	return strings.Split(mimetype.Detect(head).String(), ";")[0], nil
+}
+
+// uploadType is the reflect.Type of Upload.
+var uploadType = reflect.TypeOf(Upload{})
+
+// isUploadType reports whether the type is Upload or *Upload.
+func isUploadType(t reflect.Type) bool {
+	return t == uploadType || t == reflect.PointerTo(uploadType)
+}
+
+// uploadFields returns the settable fields of the Upload or *Upload type by their form tag names.
+func uploadFields(targetElem reflect.Value, tag string) map[string]reflect.Value {
+	fields := make(map[string]reflect.Value)
+	for i := 0; i < targetElem.NumField(); i++ {
+		field := targetElem.Type().Field(i)
+		name := strings.Split(field.Tag.Get(tag), ",")[0]
+		if name == "" || name == "-" || !targetElem.Field(i).CanSet() {
+			continue
+		}
+		if isUploadType(field.Type) {
+			fields[name] = targetElem.Field(i)
+		}
+	}
+	return fields
+}
+
+// setUpload sets the upload to the Upload or *Upload field.
+func setUpload(field reflect.Value, upload *Upload) {
+	if field.Kind() == reflect.Ptr {
+		field.Set(reflect.ValueOf(upload))
+		return
+	}
+	field.Set(reflect.ValueOf(*upload))
+}
@@ -0,0 +1,213 @@
+package binder_test
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestBindFormMultipartStream(t *testing.T) {
+	type UploadForm struct {
+		Title  string         `form:"title"`
+		Public bool           `form:"public"`
+		Avatar *binder.Upload `form:"avatar"`
+	}
+
+	testImage, err := os.ReadFile("testdata/test.jpg")
+	require.NoError(t, err)
+
+	// stream the multipart form through a pipe, so the body is never held in memory as a whole
+	newStreamRequest := func(write func(w *multipart.Writer) error) *http.Request {
+		pr, pw := io.Pipe()
+		writer := multipart.NewWriter(pw)
+		go func() {
+			err := write(writer)
+			if err == nil {
+				err = writer.Close()
+			}
+			_ = pw.CloseWithError(err)
+		}()
+
+		req := httptest.NewRequest(http.MethodPost, "/upload", pr)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		return req
+	}
+
+	t.Run("success", func(t *testing.T) {
+		req := newStreamRequest(func(w *multipart.Writer) error {
+			if err := w.WriteField("title", "Profile photo"); err != nil {
+				return err
+			}
+			if err := w.WriteField("public", "true"); err != nil {
+				return err
+			}
+			part, err := w.CreateFormFile("avatar", "test.jpg")
+			if err != nil {
+				return err
+			}
+			_, err = part.Write(testImage)
+			return err
+		})
+
+		var form UploadForm
+		err := binder.BindFormMultipartStream(req, &form)
+		require.NoError(t, err)
+		require.Equal(t, "Profile photo", form.Title)
+		require.True(t, form.Public)
+		require.NotNil(t, form.Avatar)
+		require.Equal(t, "test.jpg", form.Avatar.FileName)
+		require.Equal(t, "image/jpeg", form.Avatar.ContentType)
+
+		rc, err := form.Avatar.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		require.Equal(t, testImage, data)
+
+		// The contents are streamed, so the upload can be opened once
+		_, err = form.Avatar.Open()
+		require.ErrorIs(t, err, binder.ErrGetFile)
+	})
+
+	t.Run("upload value field", func(t *testing.T) {
+		req := newStreamRequest(func(w *multipart.Writer) error {
+			part, err := w.CreateFormFile("file", "test.txt")
+			if err != nil {
+				return err
+			}
+			_, err = part.Write([]byte("Test file data"))
+			return err
+		})
+
+		var form struct {
+			File binder.Upload `form:"file"`
+		}
+		err := binder.BindFormMultipartStream(req, &form)
+		require.NoError(t, err)
+		require.Equal(t, "text/plain", form.File.ContentType)
+
+		rc, err := form.File.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.Equal(t, "Test file data", string(data))
+	})
+
+	t.Run("values after the upload are not read", func(t *testing.T) {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("avatar", "test.jpg")
+		require.NoError(t, err)
+		_, err = part.Write(testImage)
+		require.NoError(t, err)
+		require.NoError(t, writer.WriteField("title", "Profile photo"))
+		require.NoError(t, writer.Close())
+		data := body.Bytes()
+
+		req := httptest.NewRequest(http.MethodPost, "/upload", bytes.NewReader(data))
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+
+		var form UploadForm
+		err = binder.BindFormMultipartStream(req, &form)
+		require.NoError(t, err)
+		require.NotNil(t, form.Avatar)
+		require.Empty(t, form.Title)
+
+		// The required values sent after the file are reported as missing
+		req = httptest.NewRequest(http.MethodPost, "/upload", bytes.NewReader(data))
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+
+		var requiredForm struct {
+			Title  string         `form:"title,required"`
+			Avatar *binder.Upload `form:"avatar"`
+		}
+		err = binder.BindFormMultipartStream(req, &requiredForm)
+		require.ErrorIs(t, err, binder.ErrMissingField)
+
+		var fieldErr *binder.FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		require.Equal(t, "title", fieldErr.Tag)
+	})
+
+	t.Run("required upload", func(t *testing.T) {
+		type RequiredForm struct {
+			Title  string         `form:"title"`
+			Avatar *binder.Upload `form:"avatar,required"`
+		}
+
+		req := newStreamRequest(func(w *multipart.Writer) error {
+			if err := w.WriteField("title", "Profile photo"); err != nil {
+				return err
+			}
+			part, err := w.CreateFormFile("avatar", "test.jpg")
+			if err != nil {
+				return err
+			}
+			_, err = part.Write(testImage)
+			return err
+		})
+
+		var form RequiredForm
+		require.NoError(t, binder.BindFormMultipartStream(req, &form))
+		require.NotNil(t, form.Avatar)
+		require.Equal(t, "Profile photo", form.Title)
+
+		// The upload is missing if the file part is not sent
+		req = newStreamRequest(func(w *multipart.Writer) error {
+			return w.WriteField("title", "Profile photo")
+		})
+
+		form = RequiredForm{}
+		err := binder.BindFormMultipartStream(req, &form)
+		require.ErrorIs(t, err, binder.ErrDecodeForm)
+		require.ErrorIs(t, err, binder.ErrMissingField)
+
+		var fieldErr *binder.FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		require.Equal(t, "Avatar", fieldErr.Field)
+		require.Equal(t, "avatar", fieldErr.Tag)
+	})
+
+	t.Run("multiple upload fields", func(t *testing.T) {
+		req := newStreamRequest(func(w *multipart.Writer) error {
+			return w.WriteField("title", "Profile photo")
+		})
+
+		var form struct {
+			Avatar *binder.Upload `form:"avatar"`
+			Cover  binder.Upload  `form:"cover"`
+		}
+		err := binder.BindFormMultipartStream(req, &form)
+		require.ErrorIs(t, err, binder.ErrInvalidInput)
+		require.Contains(t, err.Error(), "avatar, cover")
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		req := newStreamRequest(func(w *multipart.Writer) error {
+			return w.WriteField("public", "maybe")
+		})
+
+		var form UploadForm
+		err := binder.BindFormMultipartStream(req, &form)
+		require.ErrorIs(t, err, binder.ErrDecodeForm)
+		require.Nil(t, form.Avatar)
+	})
+
+	t.Run("invalid content type", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/upload", bytes.NewBufferString("title=photo"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		var form UploadForm
+		err := binder.BindFormMultipartStream(req, &form)
+		require.ErrorIs(t, err, binder.ErrInvalidContentType)
+	})
+}
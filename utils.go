@@ -1,9 +1,14 @@
 package binder
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"reflect"
+	"sort"
 	"strings"
+
+	"github.com/gorilla/schema"
 )
 
 // check if the request method is POST, PUT or PATCH
@@ -18,17 +23,40 @@ func isGetHeadOptionDelete(r *http.Request) bool {
 
 // check if the request content type is form urlencoded
 func isFormURLEncoded(r *http.Request) bool {
-	return strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded")
+	return hasMediaType(r, MIMEApplicationForm)
 }
 
 // check if the request content type is multipart/form-data
 func isMultipartFormData(r *http.Request) bool {
-	return strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data")
+	return hasMediaType(r, MIMEMultipartForm)
 }
 
-// check if the request content type is json
-func isJSON(r *http.Request) bool {
-	return strings.HasPrefix(r.Header.Get("Content-Type"), "application/json")
+// checkBodyRequest checks the request before decoding its body into the v pointer.
+// The request method must be POST, PUT or PATCH, the content type must be one of the media types
+// (including the media types with the matching structured suffix),
+// v must be a pointer to a struct and the body must not be empty.
+func checkBodyRequest(r *http.Request, v interface{}, mediaTypes ...string) error {
+	// Check if the request method is POST, PUT or PATCH
+	if !isPostPutPatch(r) {
+		return fmt.Errorf("%w: %s", ErrInvalidMethod, r.Method)
+	}
+
+	// Check if the request content type is one of the media types
+	if !hasMediaType(r, mediaTypes...) {
+		return fmt.Errorf("%w: %s", ErrInvalidContentType, r.Header.Get("Content-Type"))
+	}
+
+	// Validate v pointer before decoding body into it
+	if !isPointer(v) {
+		return errors.Join(ErrInvalidInput, ErrTargetMustBeAPointer)
+	}
+
+	// Check if the request body is empty
+	if r.Body == nil {
+		return ErrEmptyBody
+	}
+
+	return nil
 }
 
 // check if the passed value is a pointer
@@ -39,3 +67,153 @@ func isPointer(v interface{}) bool {
 	t := reflect.TypeOf(v)
 	return t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct
 }
+
+// taggedField describes a struct field with the binding tag.
+type taggedField struct {
+	// name is the tag name of the field.
+	name string
+	// typ is the type of the field.
+	typ reflect.Type
+	// options are the tag options following the name, e.g. "signed".
+	options []string
+	// structTag is the whole struct tag of the field, e.g. to look up the default value.
+	structTag reflect.StructTag
+	// index is the index sequence of the field in the struct, see reflect.Value.FieldByIndex.
+	index []int
+}
+
+// hasOption reports whether the field tag contains the given option.
+func (f taggedField) hasOption(option string) bool {
+	return hasOption(f.options, option)
+}
+
+// hasOption reports whether the tag options contain the given option, ignoring the surrounding spaces.
+func hasOption(options []string, option string) bool {
+	for _, o := range options {
+		if strings.TrimSpace(o) == option {
+			return true
+		}
+	}
+	return false
+}
+
+// taggedFields returns the fields with the given tag of the struct that v points to.
+// Fields of embedded structs without the tag are included as well.
+func taggedFields(v interface{}, tag string) []taggedField {
+	return structTaggedFields(reflect.TypeOf(v).Elem(), tag, nil)
+}
+
+// structTaggedFields returns the fields with the given tag of the struct type t.
+// The index sequences of the fields are prefixed with the given index of the struct.
+func structTaggedFields(t reflect.Type, tag string, index []int) []taggedField {
+	var fields []taggedField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		parts := strings.Split(field.Tag.Get(tag), ",")
+		name := parts[0]
+		fieldIndex := append(append([]int(nil), index...), i)
+
+		// Look into embedded structs without the tag
+		if name == "" && field.Anonymous {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				fields = append(fields, structTaggedFields(ft, tag, fieldIndex)...)
+			}
+			continue
+		}
+
+		// Skip if tag is empty or "-"
+		if name == "" || name == "-" || !field.IsExported() {
+			continue
+		}
+		fields = append(fields, taggedField{name: name, typ: field.Type, options: parts[1:], structTag: field.Tag, index: fieldIndex})
+	}
+	return fields
+}
+
+// decodeValues decodes the source values into the v pointer with the schema decoder.
+// The default values of the absent fields are set and the required fields are checked,
+// then the FormValueUnmarshaler fields are bound and the time values are converted,
+// see applyDefaults, checkRequired, unmarshalValues and convertTimeValues.
+// It returns BindingErrors if any field fails to decode.
+func (b *Instance) decodeValues(d *schema.Decoder, v interface{}, tag string, source Source, src map[string][]string) error {
+	src = b.applyDefaults(v, tag, src)
+	errs := checkRequired(v, tag, source, src)
+	values, unmarshalErrs := b.unmarshalValues(v, tag, source, src)
+	values, timeErrs := b.convertTimeValues(v, tag, source, values)
+	errs = append(append(errs, unmarshalErrs...), timeErrs...)
+	if err := d.Decode(v, values); err != nil {
+		var decodeErrs BindingErrors
+		if err := newBindingErrors(err, source, v, tag, src); !errors.As(err, &decodeErrs) {
+			return err
+		}
+		for _, fieldErr := range decodeErrs {
+			// The top-level required fields are checked by checkRequired already,
+			// the values bound before decoding are not seen by the decoder
+			if errors.Is(fieldErr.Err, ErrMissingField) && !strings.Contains(fieldErr.Tag, ".") {
+				continue
+			}
+			errs = append(errs, fieldErr)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	// Keep the errors order stable
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Tag < errs[j].Tag
+	})
+	return errs
+}
+
+// usedTags reports which of the given tags are set on the fields of the struct that v points to.
+// Fields of embedded structs without the tag are included as well.
+// The empty tag name is reported for the exported fields without any of the given tags,
+// the fields skipped with the "-" tag are not reported.
+func usedTags(v interface{}, tags ...string) map[string]bool {
+	used := make(map[string]bool, len(tags))
+	structUsedTags(reflect.TypeOf(v).Elem(), tags, used)
+	return used
+}
+
+// structUsedTags marks the given tags set on the fields of the struct type t as used.
+func structUsedTags(t reflect.Type, tags []string, used map[string]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tagged, skipped := false, false
+		for _, tag := range tags {
+			name, ok := field.Tag.Lookup(tag)
+			switch {
+			case ok && name != "-":
+				used[tag] = true
+				tagged = true
+			case ok:
+				skipped = true
+			}
+		}
+
+		if tagged {
+			continue
+		}
+
+		// Look into embedded structs without the tags
+		ft := field.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if field.Anonymous && ft.Kind() == reflect.Struct {
+			structUsedTags(ft, tags, used)
+		} else if field.IsExported() && !skipped {
+			used[""] = true
+		}
+	}
+}
+
+// hasBody reports whether the request has a body to bind.
+func hasBody(r *http.Request) bool {
+	return isPostPutPatch(r) && r.Body != nil && r.Body != http.NoBody && r.ContentLength != 0
+}
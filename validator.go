@@ -0,0 +1,193 @@
+package binder
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Validator is the interface that wraps the Validate method.
+//
+// Validate validates the passed v pointer right after the request has been bound into it.
+// It should return BindingErrors to report the failed fields,
+// any other error is reported as a whole.
+type Validator interface {
+	Validate(v interface{}) error
+}
+
+// ValidatorFunc is an adapter to allow the use of ordinary functions as validators.
+type ValidatorFunc func(v interface{}) error
+
+// Validate implements the Validator interface.
+func (f ValidatorFunc) Validate(v interface{}) error {
+	return f(v)
+}
+
+// SelfValidator is the interface implemented by binding targets that can validate themselves.
+// The Validate method is called after the configured Validator, it is not called if there is no validator.
+type SelfValidator interface {
+	Validate() error
+}
+
+// PlaygroundValidator adapts the github.com/go-playground/validator validator to the Validator interface.
+// It reports the validation errors as BindingErrors, the tags of which are the request keys of the fields,
+// e.g. "address.city" for the `json:"address"` and `json:"city"` fields.
+type PlaygroundValidator struct {
+	validate *validator.Validate
+}
+
+// NewPlaygroundValidator creates a new validator adapter.
+// If v is nil, a new validator with the required struct option enabled is used.
+func NewPlaygroundValidator(v *validator.Validate) *PlaygroundValidator {
+	if v == nil {
+		v = validator.New(validator.WithRequiredStructEnabled())
+	}
+	return &PlaygroundValidator{validate: v}
+}
+
+// Validate implements the Validator interface.
+func (pv *PlaygroundValidator) Validate(v interface{}) error {
+	err := pv.validate.Struct(v)
+
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return err
+	}
+
+	t := reflect.TypeOf(v)
+	errs := make(BindingErrors, 0, len(validationErrs))
+	for _, fe := range validationErrs {
+		field := trimNamespace(fe.StructNamespace())
+		errs = append(errs, &FieldError{
+			Field: field,
+			Tag:   requestKey(t, field, requestTags),
+			Value: fmt.Sprint(fe.Value()),
+			Type:  fe.Type().String(),
+			Err:   fmt.Errorf("%w on the %q rule", ErrValidation, fe.Tag()),
+		})
+	}
+
+	return errs
+}
+
+// validate runs the configured validator and the target's own Validate method.
+// It returns nil if v is valid or the validator is not configured.
+func (b *Instance) validate(v interface{}) error {
+	if b.validator == nil {
+		return nil
+	}
+	if err := b.validator.Validate(v); err != nil {
+		b.setRequestKeys(v, err)
+		return validationError(err)
+	}
+
+	if sv, ok := v.(SelfValidator); ok {
+		if err := sv.Validate(); err != nil {
+			return validationError(err)
+		}
+	}
+
+	return nil
+}
+
+// setRequestKeys sets the tags of the field errors returned by the validator to the request keys
+// looked up in the tags the binder is configured with, e.g. with WithQueryTag,
+// so the validation errors are consistent with the binding errors.
+// The field errors without the struct field path are kept as is.
+func (b *Instance) setRequestKeys(v interface{}, err error) {
+	var errs BindingErrors
+	if !isPointer(v) || !errors.As(err, &errs) {
+		return
+	}
+	tags := []string{"json", b.formTag, b.queryTag, b.pathTag, b.headerTag, b.cookieTag, "xml", "yaml", "toml", "msgpack", "cbor"}
+	for _, fieldErr := range errs {
+		if fieldErr.Field != "" {
+			fieldErr.Tag = requestKey(reflect.TypeOf(v), fieldErr.Field, tags)
+		}
+	}
+}
+
+// validationError wraps the validation error with the ErrValidation error.
+// The field errors are marked with ErrValidation as well.
+func validationError(err error) error {
+	var errs BindingErrors
+	if errors.As(err, &errs) {
+		for _, fieldErr := range errs {
+			if fieldErr.Err == nil {
+				fieldErr.Err = ErrValidation
+			} else if !errors.Is(fieldErr.Err, ErrValidation) {
+				fieldErr.Err = fmt.Errorf("%w: %w", ErrValidation, fieldErr.Err)
+			}
+		}
+	}
+	return errors.Join(ErrValidation, err)
+}
+
+// trimNamespace removes the root struct name from the validator namespace,
+// e.g. "User.Address.City" becomes "Address.City".
+func trimNamespace(ns string) string {
+	if i := strings.IndexByte(ns, '.'); i >= 0 {
+		return ns[i+1:]
+	}
+	return ns
+}
+
+// requestTags are the default struct tags the request key of the field is looked up in, in order.
+var requestTags = []string{"json", TagForm, TagQuery, TagPath, TagHeader, TagCookie, "xml", "yaml", "toml", "msgpack", "cbor"}
+
+// requestKey converts the struct field path of the type, e.g. "Address.Items[0].Name",
+// to the path of the request keys looked up in the given tags, e.g. "address.items[0].name".
+// The fields without the request key keep their names, the embedded ones are omitted.
+func requestKey(t reflect.Type, path string, tags []string) string {
+	keys := make([]string, 0, strings.Count(path, ".")+1)
+	for _, part := range strings.Split(path, ".") {
+		name, index, _ := strings.Cut(part, "[")
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return path
+		}
+		field, ok := t.FieldByName(name)
+		if !ok {
+			return path
+		}
+
+		key := fieldKey(field, tags)
+		if key != "" {
+			if index != "" {
+				key += "[" + index
+			}
+			keys = append(keys, key)
+		} else if !field.Anonymous {
+			keys = append(keys, part)
+		}
+
+		// Step into the elements of the indexed slices, arrays and maps
+		t = field.Type
+		for i := strings.Count(part, "["); i > 0; i-- {
+			for t.Kind() == reflect.Ptr {
+				t = t.Elem()
+			}
+			if t.Kind() != reflect.Slice && t.Kind() != reflect.Array && t.Kind() != reflect.Map {
+				return path
+			}
+			t = t.Elem()
+		}
+	}
+	return strings.Join(keys, ".")
+}
+
+// fieldKey returns the request key of the struct field looked up in the given tags,
+// or an empty string if the field has none of them.
+func fieldKey(field reflect.StructField, tags []string) string {
+	for _, tag := range tags {
+		if name := strings.Split(field.Tag.Get(tag), ",")[0]; name != "" && name != "-" {
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,195 @@
+package binder_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+type signupForm struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,min=8"`
+	Confirm  string `json:"confirm"`
+}
+
+// Validate implements the binder.SelfValidator interface.
+func (f *signupForm) Validate() error {
+	if f.Password != f.Confirm {
+		return binder.BindingErrors{{Field: "Confirm", Tag: "confirm", Err: errors.New("passwords do not match")}}
+	}
+	return nil
+}
+
+func TestValidator(t *testing.T) {
+	b := binder.New(binder.WithValidator(binder.NewPlaygroundValidator(nil)))
+
+	t.Run("valid", func(t *testing.T) {
+		req, err := newJSONRequest(http.MethodPost, "/signup", map[string]interface{}{
+			"email":    "john@example.com",
+			"password": "secret123",
+			"confirm":  "secret123",
+		}, nil)
+		require.NoError(t, err)
+
+		var form signupForm
+		err = b.Bind(req, &form)
+		require.NoError(t, err)
+		require.Equal(t, "john@example.com", form.Email)
+	})
+
+	t.Run("playground validation errors", func(t *testing.T) {
+		req, err := newJSONRequest(http.MethodPost, "/signup", map[string]interface{}{
+			"email":    "john",
+			"password": "secret",
+		}, nil)
+		require.NoError(t, err)
+
+		var form signupForm
+		err = b.BindJSON(req, &form)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrValidation)
+
+		var errs binder.BindingErrors
+		require.True(t, errors.As(err, &errs))
+		require.Len(t, errs, 2)
+		require.Equal(t, "Email", errs[0].Field)
+		require.Equal(t, "email", errs[0].Tag)
+		require.Equal(t, "john", errs[0].Value)
+		require.Equal(t, "Password", errs[1].Field)
+		require.Equal(t, "password", errs[1].Tag)
+
+		problem := binder.NewProblem(err)
+		require.Equal(t, http.StatusUnprocessableEntity, problem.Status)
+		require.Len(t, problem.Errors, 2)
+		require.Equal(t, `validation failed on the "email" rule`, problem.Errors[0].Detail)
+	})
+
+	t.Run("self validation", func(t *testing.T) {
+		req, err := newJSONRequest(http.MethodPost, "/signup", map[string]interface{}{
+			"email":    "john@example.com",
+			"password": "secret123",
+			"confirm":  "secret321",
+		}, nil)
+		require.NoError(t, err)
+
+		var form signupForm
+		err = b.BindJSON(req, &form)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrValidation)
+
+		var fieldErr *binder.FieldError
+		require.True(t, errors.As(err, &fieldErr))
+		require.Equal(t, "confirm", fieldErr.Tag)
+		require.ErrorIs(t, fieldErr, binder.ErrValidation)
+	})
+
+	t.Run("self validation without validator", func(t *testing.T) {
+		req, err := newJSONRequest(http.MethodPost, "/signup", map[string]interface{}{
+			"password": "secret123",
+			"confirm":  "secret321",
+		}, nil)
+		require.NoError(t, err)
+
+		// The target's own Validate method is not called if the validator is not configured
+		var form signupForm
+		err = binder.BindJSON(req, &form)
+		require.NoError(t, err)
+		require.Equal(t, "secret321", form.Confirm)
+	})
+
+	t.Run("nested request keys", func(t *testing.T) {
+		type Item struct {
+			Name string `json:"name" validate:"required"`
+		}
+		type Base struct {
+			ID int `json:"id" validate:"min=1"`
+		}
+		type Order struct {
+			Base
+			Items []Item `json:"items" validate:"dive"`
+			Note  string `validate:"max=3"`
+		}
+
+		req, err := newJSONRequest(http.MethodPost, "/orders", map[string]interface{}{
+			"id":    0,
+			"items": []map[string]string{{"name": "pen"}, {}},
+			"Note":  "long",
+		}, nil)
+		require.NoError(t, err)
+
+		var order Order
+		err = b.BindJSON(req, &order)
+		require.ErrorIs(t, err, binder.ErrValidation)
+
+		var errs binder.BindingErrors
+		require.True(t, errors.As(err, &errs))
+		require.Len(t, errs, 3)
+		require.Equal(t, "Base.ID", errs[0].Field)
+		require.Equal(t, "id", errs[0].Tag)
+		require.Equal(t, "Items[1].Name", errs[1].Field)
+		require.Equal(t, "items[1].name", errs[1].Tag)
+		require.Equal(t, "Note", errs[2].Field)
+		require.Equal(t, "Note", errs[2].Tag)
+
+		problem := binder.NewProblem(err)
+		require.Equal(t, "items[1].name", problem.Errors[1].Name)
+	})
+
+	t.Run("custom tags", func(t *testing.T) {
+		b := binder.New(
+			binder.WithQueryTag("q"),
+			binder.WithValidator(binder.NewPlaygroundValidator(nil)),
+		)
+
+		var params struct {
+			PerPage int `q:"per_page" validate:"max=100"`
+		}
+		req := httptest.NewRequest(http.MethodGet, "/?per_page=500", nil)
+		err := b.BindQuery(req, &params)
+		require.ErrorIs(t, err, binder.ErrValidation)
+
+		var fieldErr *binder.FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		require.Equal(t, "PerPage", fieldErr.Field)
+		require.Equal(t, "per_page", fieldErr.Tag)
+	})
+
+	t.Run("validator func", func(t *testing.T) {
+		errInvalid := errors.New("invalid page")
+		b := binder.New(binder.WithValidator(binder.ValidatorFunc(func(v interface{}) error {
+			if v.(*struct {
+				Page int `query:"page"`
+			}).Page < 1 {
+				return errInvalid
+			}
+			return nil
+		})))
+
+		req := httptest.NewRequest(http.MethodGet, "/?page=0", nil)
+
+		var params struct {
+			Page int `query:"page"`
+		}
+		err := b.Bind(req, &params)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrValidation)
+		require.ErrorIs(t, err, errInvalid)
+	})
+
+	t.Run("decode error skips validation", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/?page=one", nil)
+
+		var params struct {
+			Page int `query:"page" validate:"min=1"`
+		}
+		err := b.BindQuery(req, &params)
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrDecodeQuery)
+		require.NotErrorIs(t, err, binder.ErrValidation)
+	})
+}
@@ -0,0 +1,45 @@
+package binder
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+
+	"golang.org/x/net/html/charset"
+)
+
+// BindXML binds the passed v pointer to the request using the default binder instance.
+// See Instance.BindXML for details.
+// Implements the binder.BinderFunc interface.
+func BindXML(r *http.Request, v interface{}) error {
+	return defaultInstance.BindXML(r, v)
+}
+
+// BindXML binds the passed v pointer to the request.
+// It uses the application/xml or text/xml content type for binding.
+// `v` param should be a pointer to a struct with `xml` tags.
+// Bodies in the non-UTF-8 charsets, e.g. <?xml version="1.0" encoding="ISO-8859-1"?>, are converted to UTF-8.
+// The bound value is validated afterwards, see WithValidator.
+func (b *Instance) BindXML(r *http.Request, v interface{}) error {
+	if err := b.decodeBody(r, v, b.bindXML); err != nil {
+		return err
+	}
+	return b.validate(v)
+}
+
+// bindXML binds the passed v pointer to the request XML body without validation.
+func (b *Instance) bindXML(r *http.Request, v interface{}) error {
+	// Check the request and the v pointer before decoding the body into it
+	if err := checkBodyRequest(r, v, MIMEApplicationXML, MIMETextXML); err != nil {
+		return err
+	}
+
+	// Decode the request body into the v pointer, converting the charset declared in the XML to UTF-8
+	dec := xml.NewDecoder(r.Body)
+	dec.CharsetReader = charset.NewReaderLabel
+	if err := dec.Decode(v); err != nil {
+		return errors.Join(ErrDecodeXML, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package binder_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestBindXML(t *testing.T) {
+	// A test struct with xml tags
+	type RequestBody struct {
+		FieldOne string `xml:"field_one"`
+		FieldTwo int    `xml:"field_two"`
+	}
+
+	payload := `<request><field_one>value</field_one><field_two>123</field_two></request>`
+
+	// new xml request with body and content type
+	newXMLRequest := func(method, contentType, body string) *http.Request {
+		req, err := http.NewRequest(method, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		return req
+	}
+
+	t.Run("invalid method", func(t *testing.T) {
+		req := newXMLRequest(http.MethodGet, "application/xml", payload)
+
+		err := binder.BindXML(req, &RequestBody{})
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrInvalidMethod)
+	})
+
+	t.Run("invalid content type", func(t *testing.T) {
+		req := newXMLRequest(http.MethodPost, "application/json", payload)
+
+		err := binder.BindXML(req, &RequestBody{})
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrInvalidContentType)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		req := newXMLRequest(http.MethodPost, "application/xml", payload)
+
+		err := binder.BindXML(req, RequestBody{})
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrInvalidInput)
+	})
+
+	t.Run("empty body", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/xml")
+
+		err = binder.BindXML(req, &RequestBody{})
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrEmptyBody)
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		req := newXMLRequest(http.MethodPost, "application/xml", `<request><field_two>abc</field_two></request>`)
+
+		err := binder.BindXML(req, &RequestBody{})
+		require.Error(t, err)
+		require.ErrorIs(t, err, binder.ErrDecodeXML)
+	})
+
+	t.Run("successful case", func(t *testing.T) {
+		for _, contentType := range []string{"application/xml", "text/xml; charset=utf-8"} {
+			req := newXMLRequest(http.MethodPost, contentType, payload)
+
+			obj := &RequestBody{}
+			err := binder.BindFunc(req, obj)
+			require.NoError(t, err)
+			require.Equal(t, "value", obj.FieldOne)
+			require.Equal(t, 123, obj.FieldTwo)
+		}
+	})
+
+	t.Run("non-UTF-8 charset", func(t *testing.T) {
+		// "café" encoded in ISO-8859-1
+		body := "<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?>\n<request><field_one>caf\xe9</field_one><field_two>7</field_two></request>"
+		req := newXMLRequest(http.MethodPost, "application/xml", body)
+
+		obj := &RequestBody{}
+		err := binder.BindXML(req, obj)
+		require.NoError(t, err)
+		require.Equal(t, "café", obj.FieldOne)
+		require.Equal(t, 7, obj.FieldTwo)
+	})
+
+	t.Run("unsupported charset", func(t *testing.T) {
+		body := `<?xml version="1.0" encoding="x-unknown"?><request><field_one>value</field_one></request>`
+		req := newXMLRequest(http.MethodPost, "application/xml", body)
+
+		err := binder.BindXML(req, &RequestBody{})
+		require.ErrorIs(t, err, binder.ErrDecodeXML)
+		require.Contains(t, err.Error(), "x-unknown")
+	})
+}
@@ -0,0 +1,68 @@
+package binder
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"gopkg.in/yaml.v3"
+)
+
+// BindYAML binds the passed v pointer to the request using the default binder instance.
+// See Instance.BindYAML for details.
+// Implements the binder.BinderFunc interface.
+func BindYAML(r *http.Request, v interface{}) error {
+	return defaultInstance.BindYAML(r, v)
+}
+
+// BindYAML binds the passed v pointer to the request.
+// It uses the application/yaml content type for binding.
+// `v` param should be a pointer to a struct with `yaml` tags.
+// If the struct has no `yaml` tags, its `json` tags are used instead,
+// so the same struct can be bound from JSON and YAML.
+// The bound value is validated afterwards, see WithValidator.
+func (b *Instance) BindYAML(r *http.Request, v interface{}) error {
+	if err := b.decodeBody(r, v, b.bindYAML); err != nil {
+		return err
+	}
+	return b.validate(v)
+}
+
+// bindYAML binds the passed v pointer to the request YAML body without validation.
+func (b *Instance) bindYAML(r *http.Request, v interface{}) error {
+	// Check the request and the v pointer before decoding the body into it
+	if err := checkBodyRequest(r, v, MIMEApplicationYAML, MIMEApplicationXYAML, MIMETextYAML); err != nil {
+		return err
+	}
+
+	// Decode the request body straight into the v pointer if it has yaml tags
+	if usedTags(v, "yaml")["yaml"] {
+		if err := yaml.NewDecoder(r.Body).Decode(v); err != nil {
+			return errors.Join(ErrDecodeYAML, err)
+		}
+		return nil
+	}
+
+	// Otherwise decode the body into a generic value and bind it with the json tags
+	var doc interface{}
+	if err := yaml.NewDecoder(r.Body).Decode(&doc); err != nil {
+		return errors.Join(ErrDecodeYAML, err)
+	}
+	if err := rebindJSON(doc, v); err != nil {
+		return errors.Join(ErrDecodeYAML, err)
+	}
+
+	return nil
+}
+
+// rebindJSON binds the generic document into the v pointer using the json tags.
+func rebindJSON(doc, v interface{}) error {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return newJSONBindingErrors(err, v, data)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package binder_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dmitrymomot/binder"
+)
+
+func TestBindYAML(t *testing.T) {
+	// new yaml request with body and content type
+	newYAMLRequest := func(method, contentType, body string) *http.Request {
+		req, err := http.NewRequest(method, "/", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		return req
+	}
+
+	payload := "field_one: value\nfield_two: 123\ntags: [a, b]\n"
+
+	t.Run("invalid method", func(t *testing.T) {
+		var obj struct{}
+		err := binder.BindYAML(newYAMLRequest(http.MethodGet, "application/yaml", payload), &obj)
+		require.ErrorIs(t, err, binder.ErrInvalidMethod)
+	})
+
+	t.Run("invalid content type", func(t *testing.T) {
+		var obj struct{}
+		err := binder.BindYAML(newYAMLRequest(http.MethodPost, "application/json", payload), &obj)
+		require.ErrorIs(t, err, binder.ErrInvalidContentType)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		var obj struct{}
+		err := binder.BindYAML(newYAMLRequest(http.MethodPost, "application/yaml", payload), obj)
+		require.ErrorIs(t, err, binder.ErrInvalidInput)
+	})
+
+	t.Run("yaml tags", func(t *testing.T) {
+		var obj struct {
+			FieldOne string   `yaml:"field_one"`
+			FieldTwo int      `yaml:"field_two"`
+			Tags     []string `yaml:"tags"`
+		}
+		err := binder.BindYAML(newYAMLRequest(http.MethodPost, "application/yaml", payload), &obj)
+		require.NoError(t, err)
+		require.Equal(t, "value", obj.FieldOne)
+		require.Equal(t, 123, obj.FieldTwo)
+		require.Equal(t, []string{"a", "b"}, obj.Tags)
+	})
+
+	t.Run("json tags", func(t *testing.T) {
+		var obj struct {
+			FieldOne string   `json:"field_one"`
+			FieldTwo int      `json:"field_two"`
+			Tags     []string `json:"tags"`
+		}
+		err := binder.BindFunc(newYAMLRequest(http.MethodPut, "application/x-yaml", payload), &obj)
+		require.NoError(t, err)
+		require.Equal(t, "value", obj.FieldOne)
+		require.Equal(t, 123, obj.FieldTwo)
+		require.Equal(t, []string{"a", "b"}, obj.Tags)
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		var obj struct {
+			FieldTwo int `json:"field_two"`
+		}
+		err := binder.BindYAML(newYAMLRequest(http.MethodPost, "text/yaml", "field_two: abc\n"), &obj)
+		require.ErrorIs(t, err, binder.ErrDecodeYAML)
+		require.ErrorIs(t, err, binder.ErrInvalidValue)
+	})
+}